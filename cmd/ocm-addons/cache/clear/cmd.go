@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clear
+
+import (
+	"fmt"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return generateCommand(run)
+}
+
+func generateCommand(run func(*cobra.Command, []string) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear",
+		Short: "remove all cached add-on data",
+		Long:  "Removes all cached responses so that add-on data is requested from OCM on the next invocation.",
+		Args:  cobra.NoArgs,
+		RunE:  run,
+	}
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	dir, err := cli.CacheDir()
+	if err != nil {
+		return err
+	}
+
+	removed, err := ocm.NewCache(dir, 0).Clear()
+	if err != nil {
+		return fmt.Errorf("clearing cache %q: %w", dir, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "removed %d cached responses from %s\n", removed, dir)
+
+	return nil
+}
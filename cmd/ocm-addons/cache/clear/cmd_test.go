@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clear
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command: mockCommand(),
+			reports: []interface{}{"should execute successfully"},
+		},
+		"unexpected argument": {
+			command:     mockCommand(),
+			args:        []string{"addons"},
+			expectation: `unknown command "addons" for "clear"`,
+			reports:     []interface{}{"should fail expecting no args"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(testutil.NoOp)
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	cacheclear "github.com/mt-sre/ocm-addons/cmd/ocm-addons/cache/clear"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return generateCommand()
+}
+
+func generateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache [command]",
+		Short: "manage cached add-on data",
+		Long: "Manages the on-disk cache of add-on catalog and version data which is used when " +
+			"'--cache-ttl', $OCM_ADDONS_CACHE_TTL or 'cache.ttl' in the plug-in config is set.",
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(cacheclear.Cmd())
+
+	return cmd
+}
@@ -5,6 +5,7 @@
 package events
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -32,46 +33,201 @@ func Cmd() *cobra.Command {
 
 	opts.AfterUsage("returns log events which occurred after the specified time (YYYY-MM-DD HH:mm:ss)")
 
+	opts.bucketIn = ocm.LogBucketDay
+	opts.groupByIn = ocm.LogGroupBySeverity
+	opts.Top = 10
+
 	return generateCommand(&opts, run(&opts))
 }
 
 type options struct {
+	cli.ClusterSelectionOptions
 	cli.CommonOptions
 	cli.SearchOptions
 	cli.FilterOptions
-	Level   ocm.LogLevel
-	levelIn string
+	cli.PagingOptions
+	Levels     []ocm.LogLevel
+	levelsIn   []string
+	MinLevel   ocm.LogLevel
+	minLevelIn string
+	Stats      bool
+	Bucket     ocm.LogBucketSize
+	bucketIn   string
+	GroupBy    ocm.LogGroupBy
+	groupByIn  string
+	Top        int
+	Export     string
+	Resume     bool
 }
 
 func (o *options) AddLevelFlag(flags *pflag.FlagSet) {
-	flags.StringVar(
-		&o.levelIn,
+	flags.StringSliceVar(
+		&o.levelsIn,
 		"level",
-		o.levelIn,
-		"Selects the level of the logs to display.",
+		o.levelsIn,
+		"comma separated list of the levels of the logs to display (debug, info, warning, error, fatal)",
+	)
+}
+
+func (o *options) AddMinLevelFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.minLevelIn,
+		"min-level",
+		o.minLevelIn,
+		"displays only logs whose level is at least as severe as the given level",
+	)
+}
+
+func (o *options) AddStatsFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Stats,
+		"stats",
+		o.Stats,
+		"displays a histogram of log events and the most frequent summaries instead of individual events",
+	)
+}
+
+func (o *options) AddBucketFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.bucketIn,
+		"bucket",
+		o.bucketIn,
+		"selects whether '--stats' buckets log events by 'hour' or 'day'",
 	)
 }
 
-func (o *options) ParseOptions() {
-	o.Level = parseLogLevel(o.levelIn)
+func (o *options) AddGroupByFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.groupByIn,
+		"group-by",
+		o.groupByIn,
+		"selects whether '--stats' groups log events by 'severity' or 'service_name'",
+	)
+}
+
+func (o *options) AddTopFlag(flags *pflag.FlagSet) {
+	flags.IntVar(
+		&o.Top,
+		"top",
+		o.Top,
+		"number of most frequent summaries displayed by '--stats'; '0' displays all summaries",
+	)
+}
+
+func (o *options) AddExportFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Export,
+		"export",
+		o.Export,
+		"writes all matching log events to the given file as JSON Lines instead of displaying them",
+	)
+}
+
+func (o *options) AddResumeFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Resume,
+		"resume",
+		o.Resume,
+		"resumes an '--export' from the last exported timestamp of each cluster",
+	)
 }
 
-func parseLogLevel(maybeLvl string) ocm.LogLevel {
+var (
+	errResumeWithoutExport = errors.New("'--resume' requires '--export'")
+	errNegativeTop         = errors.New("'--top' must not be negative")
+)
+
+func (o *options) ParseOptions() error {
+	if o.Resume && o.Export == "" {
+		return errResumeWithoutExport
+	}
+
+	if o.Top < 0 {
+		return fmt.Errorf("%d: %w", o.Top, errNegativeTop)
+	}
+
+	o.Levels = make([]ocm.LogLevel, 0, len(o.levelsIn))
+
+	for _, in := range o.levelsIn {
+		lvl, err := parseLogLevel(in)
+		if err != nil {
+			return fmt.Errorf("parsing level: %w", err)
+		}
+
+		o.Levels = append(o.Levels, lvl)
+	}
+
+	if o.minLevelIn != "" {
+		lvl, err := parseLogLevel(o.minLevelIn)
+		if err != nil {
+			return fmt.Errorf("parsing minimum level: %w", err)
+		}
+
+		o.MinLevel = lvl
+	}
+
+	bucket, err := parseBucketSize(o.bucketIn)
+	if err != nil {
+		return err
+	}
+
+	o.Bucket = bucket
+
+	groupBy, err := parseGroupBy(o.groupByIn)
+	if err != nil {
+		return err
+	}
+
+	o.GroupBy = groupBy
+
+	return nil
+}
+
+var (
+	errUnknownBucketSize = errors.New("unknown bucket size")
+	errUnknownGroupBy    = errors.New("unknown grouping")
+)
+
+func parseBucketSize(maybeSize string) (ocm.LogBucketSize, error) {
+	switch strings.ToLower(strings.TrimSpace(maybeSize)) {
+	case ocm.LogBucketHour, "hourly":
+		return ocm.LogBucketHour, nil
+	case ocm.LogBucketDay, "daily":
+		return ocm.LogBucketDay, nil
+	default:
+		return ocm.LogBucketNone, fmt.Errorf("%q: %w", maybeSize, errUnknownBucketSize)
+	}
+}
+
+func parseGroupBy(maybeGroup string) (ocm.LogGroupBy, error) {
+	switch cli.Normalize(strings.ReplaceAll(maybeGroup, "-", "_")) {
+	case ocm.LogGroupBySeverity, "level":
+		return ocm.LogGroupBySeverity, nil
+	case ocm.LogGroupByServiceName, "service":
+		return ocm.LogGroupByServiceName, nil
+	default:
+		return ocm.LogGroupNone, fmt.Errorf("%q: %w", maybeGroup, errUnknownGroupBy)
+	}
+}
+
+var errUnknownLogLevel = errors.New("unknown log level")
+
+func parseLogLevel(maybeLvl string) (ocm.LogLevel, error) {
 	usTitler := cases.Title(language.AmericanEnglish)
 
-	switch usTitler.String(strings.ToLower(maybeLvl)) {
+	switch usTitler.String(strings.ToLower(strings.TrimSpace(maybeLvl))) {
 	case ocm.LogLevelDebug:
-		return ocm.LogLevelDebug
+		return ocm.LogLevelDebug, nil
 	case ocm.LogLevelInfo:
-		return ocm.LogLevelInfo
+		return ocm.LogLevelInfo, nil
 	case ocm.LogLevelWarning:
-		return ocm.LogLevelWarning
+		return ocm.LogLevelWarning, nil
 	case ocm.LogLevelError:
-		return ocm.LogLevelError
+		return ocm.LogLevelError, nil
 	case ocm.LogLevelFatal:
-		return ocm.LogLevelFatal
+		return ocm.LogLevelFatal, nil
 	default:
-		return ocm.LogLevelNone
+		return ocm.LogLevelNone, fmt.Errorf("%q: %w", maybeLvl, errUnknownLogLevel)
 	}
 }
 
@@ -83,20 +239,34 @@ func generateCommand(opts *options, run func(*cobra.Command, []string) error) *c
 		Use:   "events [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH]",
 		Short: "retrieve add-on related cluster logs",
 		Long:  longDesc,
-		Args:  cobra.MinimumNArgs(1),
+		Args:  opts.ClusterArgs(cobra.MinimumNArgs(1)),
 		RunE:  run,
 	}
 
 	flags := cmd.Flags()
 
+	opts.AddClusterSearchFlag(flags)
 	opts.AddColumnsFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddLimitFlag(flags)
 	opts.AddNoColorFlag(flags)
 	opts.AddNoHeadersFlag(flags)
 	opts.AddOrderFlag(flags)
+	opts.AddPageSizeFlag(flags)
 	opts.AddLevelFlag(flags)
+	opts.AddMinLevelFlag(flags)
 	opts.AddBeforeFlag(flags)
 	opts.AddAfterFlag(flags)
 	opts.AddSearchFlag(flags)
+	opts.AddStatsFlag(flags)
+	opts.AddBucketFlag(flags)
+	opts.AddGroupByFlag(flags)
+	opts.AddTopFlag(flags)
+	opts.AddExportFlag(flags)
+	opts.AddResumeFlag(flags)
+
+	cmd.MarkFlagsMutuallyExclusive("level", "min-level")
+	cmd.MarkFlagsMutuallyExclusive("stats", "export")
 
 	return cmd
 }
@@ -105,50 +275,61 @@ func run(opts *options) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
-		opts.ParseOptions()
-
-		sess, err := cli.NewSession()
-		if err != nil {
+		if err := opts.ParseOptions(); err != nil {
 			return err
 		}
 
-		defer sess.End()
-
-		table, err := cli.NewTable(
-			cli.WithColumns(opts.Columns),
-			cli.WithNoHeaders(opts.NoHeaders),
-			cli.WithNoColor(opts.NoColor),
-			cli.WithPager(sess.Pager()),
-			cli.WithOutput{Out: cmd.OutOrStdout()},
-		)
+		sess, err := cli.NewSession()
 		if err != nil {
 			return err
 		}
 
-		defer table.Flush()
+		defer sess.End()
 
-		search := args[0]
+		search, _ := opts.SplitClusterArg(args)
 
 		trace := sess.Logger().
 			WithFields(log.Fields{
-				"command": "cluster events",
-				"search":  search,
+				"command":       "cluster events",
+				"search":        search,
+				"clusterSearch": opts.ClusterSearch,
 			}).
 			Trace("running command")
 		defer trace.Stop(nil)
 
-		pager, err := ocm.RetrieveClusters(sess.Conn(), trace)
+		pager, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), opts.ClusterOption())
 		if err != nil {
 			return err
 		}
 
-		matchingClusters := pager.SearchByNameOrID(search)
+		matchingClusters := opts.Select(pager.SearchByNameOrID(search))
 
 		options, err := commandOptsToGetLogsOpts(opts)
 		if err != nil {
 			return err
 		}
 
+		if opts.Stats {
+			return writeStats(ctx, cmd.OutOrStdout(), opts, matchingClusters, options)
+		}
+
+		if opts.Export != "" {
+			return writeExport(ctx, cmd.OutOrStdout(), opts, matchingClusters, options)
+		}
+
+		table, err := cli.NewTable(
+			cli.WithColumns(opts.Columns),
+			cli.WithNoHeaders(opts.NoHeaders),
+			cli.WithNoColor(opts.NoColor),
+			cli.WithPager(sess.Pager()),
+			cli.WithOutput{Out: cmd.OutOrStdout()},
+		)
+		if err != nil {
+			return err
+		}
+
+		defer table.Flush()
+
 		return matchingClusters.ForEach(ctx, func(c *ocm.Cluster) error {
 			logs, err := c.GetLogs(ctx, options)
 			if err != nil {
@@ -177,10 +358,17 @@ func commandOptsToGetLogsOpts(opts *options) (ocm.GetLogsOptions, error) {
 		return ocm.GetLogsOptions{}, err
 	}
 
+	paging, err := opts.Paging()
+	if err != nil {
+		return ocm.GetLogsOptions{}, err
+	}
+
 	return ocm.NewGetLogsOptions(
 		ocm.GetLogsMatchingPattern(pattern),
-		ocm.GetLogsWithLevel(opts.Level),
-		ocm.GetLogsSorted(ocm.LogEntryByTime(opts.Order)),
+		ocm.GetLogsWithLevel(opts.Levels...),
+		ocm.GetLogsWithMinLevel(opts.MinLevel),
+		ocm.GetLogsByTime(opts.Order),
+		ocm.GetLogsPaged(paging),
 		ocm.GetLogsBefore(opts.Before),
 		ocm.GetLogsAfter(opts.After),
 	), nil
@@ -7,8 +7,10 @@ package events
 import (
 	"testing"
 
+	"github.com/mt-sre/ocm-addons/internal/ocm"
 	"github.com/mt-sre/ocm-addons/internal/testutil"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCmdArguments(t *testing.T) {
@@ -110,6 +112,14 @@ func TestCmdOptions(t *testing.T) {
 			},
 			reports: []interface{}{"should execute successfully"},
 		},
+		"paging flags": {
+			command: mockCommand(),
+			args: []string{
+				"--page-size", "100", "--limit", "500",
+				"fake-cluster-name",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
 		"before flag with no arguments": {
 			command:     mockCommand(),
 			args:        []string{"--before"},
@@ -138,6 +148,73 @@ func TestCmdOptions(t *testing.T) {
 			},
 			reports: []interface{}{"should execute successfully"},
 		},
+		"level flag with multiple arguments": {
+			command: mockCommand(),
+			args: []string{
+				"--level", "warning,error",
+				"fake-cluster-name",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"min-level flag with single argument": {
+			command: mockCommand(),
+			args: []string{
+				"--min-level", "warning",
+				"fake-cluster-name",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"level and min-level flags": {
+			command: mockCommand(),
+			args: []string{
+				"--level", "error",
+				"--min-level", "warning",
+				"fake-cluster-name",
+			},
+			expectation: "none of the others can be",
+			reports:     []interface{}{"should report mutually exclusive flags"},
+		},
+		"stats flag": {
+			command: mockCommand(),
+			args:    []string{"--stats", "fake-cluster-name"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"stats flag with bucket and group-by": {
+			command: mockCommand(),
+			args: []string{
+				"--stats",
+				"--bucket", "hour",
+				"--group-by", "service_name",
+				"--top", "5",
+				"fake-cluster-name",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"export flag with resume": {
+			command: mockCommand(),
+			args: []string{
+				"--export", "logs.jsonl",
+				"--resume",
+				"fake-cluster-name",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"export and stats flags": {
+			command: mockCommand(),
+			args: []string{
+				"--export", "logs.jsonl",
+				"--stats",
+				"fake-cluster-name",
+			},
+			expectation: "none of the others can be",
+			reports:     []interface{}{"should report mutually exclusive flags"},
+		},
+		"top flag with non-integer argument": {
+			command:     mockCommand(),
+			args:        []string{"--top", "many", "fake-cluster-name"},
+			expectation: "invalid argument",
+			reports:     []interface{}{"should report invalid argument"},
+		},
 	}
 
 	for name, test := range testcases {
@@ -157,6 +234,106 @@ func TestCmdOptions(t *testing.T) {
 	}
 }
 
+func TestParseLogLevel(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Input    string
+		Expected ocm.LogLevel
+		Error    bool
+	}{
+		"lowercase": {
+			Input:    "warning",
+			Expected: ocm.LogLevelWarning,
+		},
+		"uppercase with whitespace": {
+			Input:    " ERROR ",
+			Expected: ocm.LogLevelError,
+		},
+		"unknown level": {
+			Input: "critical",
+			Error: true,
+		},
+		"empty": {
+			Input: "",
+			Error: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lvl, err := parseLogLevel(tc.Input)
+			if tc.Error {
+				require.ErrorIs(t, err, errUnknownLogLevel)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.Expected, lvl)
+		})
+	}
+}
+
+func TestParseStatsOptions(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Bucket  string
+		GroupBy string
+		Top     int
+		Error   error
+	}{
+		"hourly by severity": {
+			Bucket:  "hour",
+			GroupBy: "severity",
+		},
+		"daily by service name": {
+			Bucket:  "Day",
+			GroupBy: "service-name",
+		},
+		"unknown bucket": {
+			Bucket:  "week",
+			GroupBy: "severity",
+			Error:   errUnknownBucketSize,
+		},
+		"unknown grouping": {
+			Bucket:  "day",
+			GroupBy: "cluster",
+			Error:   errUnknownGroupBy,
+		},
+		"negative top": {
+			Bucket:  "day",
+			GroupBy: "severity",
+			Top:     -1,
+			Error:   errNegativeTop,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := options{
+				bucketIn:  tc.Bucket,
+				groupByIn: tc.GroupBy,
+				Top:       tc.Top,
+			}
+
+			err := opts.ParseOptions()
+			if tc.Error != nil {
+				require.ErrorIs(t, err, tc.Error)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func mockCommand() *cobra.Command {
 	return generateCommand(new(options), testutil.NoOp)
 }
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+)
+
+// writeExport writes the logs of all matching clusters to the export
+// file in ascending order by time. The logs of each cluster are
+// requested page by page so that a limit keeps the earliest entries
+// and a resumed export continues after them. Nothing is written to the
+// export file unless all logs were retrieved successfully.
+func writeExport(
+	ctx context.Context,
+	out io.Writer,
+	opts *options,
+	clusters *ocm.ClusterPager,
+	getLogsOpts ocm.GetLogsOptions,
+) error {
+	exp, err := newLogExporter(opts.Export, opts.Resume)
+	if err != nil {
+		return err
+	}
+
+	defer exp.Abort()
+
+	getLogsOpts = getLogsOpts.With(
+		ocm.GetLogsByTime(ocm.OrderAsc),
+	)
+
+	if err := clusters.ForEach(ctx, func(c *ocm.Cluster) error {
+		clusterOpts := getLogsOpts
+
+		if from := exp.ResumeFrom(c.ExternalID()); from.After(opts.After) {
+			clusterOpts = getLogsOpts.With(ocm.GetLogsAfter(from))
+		}
+
+		logs, err := c.GetLogs(ctx, clusterOpts)
+		if err != nil {
+			return err
+		}
+
+		for i := range logs {
+			if err := exp.Write(&logs[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := exp.Commit(); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, "exported %d log events to %s\n", exp.Written(), opts.Export)
+
+	return err
+}
+
+// newLogExporter prepares a JSON Lines export to the given path. Entries
+// are written to a temporary file in the same directory which replaces
+// the target only once the export is committed. If 'resume' is set any
+// entries already present at the target are carried over and the last
+// seen timestamp for each cluster is recorded so that only newer entries
+// need to be requested.
+func newLogExporter(path string, resume bool) (*logExporter, error) {
+	tmp, err := createExportTemp(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary export file: %w", err)
+	}
+
+	exp := &logExporter{
+		path:     path,
+		tmp:      tmp,
+		w:        bufio.NewWriter(tmp),
+		lastSeen: make(map[string]time.Time),
+		seen:     make(map[string]map[string]struct{}),
+	}
+
+	if !resume {
+		return exp, nil
+	}
+
+	if err := exp.carryOver(); err != nil {
+		exp.Abort()
+
+		return nil, err
+	}
+
+	return exp, nil
+}
+
+// createExportTemp creates a temporary file next to the export file at
+// path. The temporary file is given the permissions of an existing
+// export file or otherwise, like os.Create, 0666 before umask so that
+// replacing the export file does not change its permissions.
+func createExportTemp(path string) (*os.File, error) {
+	dir, base := filepath.Split(path)
+
+	for i := 0; i < maxTempAttempts; i++ {
+		name := filepath.Join(dir, fmt.Sprintf(".%s.%d.tmp", base, rand.Uint32())) //nolint:gosec
+
+		tmp, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o666)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		info, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return tmp, nil
+		} else if err == nil {
+			err = tmp.Chmod(info.Mode().Perm())
+		}
+
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+
+			return nil, err
+		}
+
+		return tmp, nil
+	}
+
+	return nil, fmt.Errorf("%q: %w", path, errTempExists)
+}
+
+const maxTempAttempts = 100
+
+var errTempExists = errors.New("no unused temporary file name found")
+
+type logExporter struct {
+	path     string
+	tmp      *os.File
+	w        *bufio.Writer
+	lastSeen map[string]time.Time
+	// seen holds per cluster the IDs of all exported entries. A resumed
+	// export requests entries from the whole second of the last seen
+	// timestamp again since OCM timestamps in search queries are
+	// truncated to seconds.
+	seen    map[string]map[string]struct{}
+	written int
+	done    bool
+}
+
+const maxExportLineSize = 1024 * 1024
+
+func (e *logExporter) carryOver() error {
+	existing, err := os.Open(e.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("opening existing export: %w", err)
+	}
+
+	defer existing.Close()
+
+	scanner := bufio.NewScanner(existing)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxExportLineSize)
+
+	var lineNum int
+
+	for scanner.Scan() {
+		lineNum++
+
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry ocm.LogEntry
+
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("reading existing export line %d: %w", lineNum, err)
+		}
+
+		e.observe(entry)
+
+		if err := e.writeLine(line); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading existing export: %w", err)
+	}
+
+	return nil
+}
+
+func (e *logExporter) observe(entry ocm.LogEntry) {
+	uuid := entry.Entry.ClusterUUID()
+
+	if ts := entry.Entry.Timestamp(); ts.After(e.lastSeen[uuid]) {
+		e.lastSeen[uuid] = ts
+	}
+
+	if e.seen[uuid] == nil {
+		e.seen[uuid] = make(map[string]struct{})
+	}
+
+	e.seen[uuid][entry.Entry.ID()] = struct{}{}
+}
+
+// ResumeFrom returns the timestamp of the last exported entry for the
+// given cluster or the zero time if no entries were exported.
+func (e *logExporter) ResumeFrom(clusterUUID string) time.Time {
+	return e.lastSeen[clusterUUID]
+}
+
+// Write appends an entry to the export unless it was already exported.
+func (e *logExporter) Write(entry *ocm.LogEntry) error {
+	if _, ok := e.seen[entry.Entry.ClusterUUID()][entry.Entry.ID()]; ok {
+		return nil
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding log entry: %w", err)
+	}
+
+	if err := e.writeLine(data); err != nil {
+		return err
+	}
+
+	e.observe(*entry)
+	e.written++
+
+	return nil
+}
+
+func (e *logExporter) writeLine(line []byte) error {
+	if _, err := e.w.Write(line); err != nil {
+		return fmt.Errorf("writing export: %w", err)
+	}
+
+	if err := e.w.WriteByte('\n'); err != nil {
+		return fmt.Errorf("writing export: %w", err)
+	}
+
+	return nil
+}
+
+// Written returns the number of new entries written during this export.
+func (e *logExporter) Written() int { return e.written }
+
+// Commit flushes all written entries and atomically replaces the
+// target file with the completed export. If the export cannot be
+// committed it is aborted.
+func (e *logExporter) Commit() error {
+	defer e.Abort()
+
+	if err := e.w.Flush(); err != nil {
+		return fmt.Errorf("flushing export: %w", err)
+	}
+
+	if err := e.tmp.Sync(); err != nil {
+		return fmt.Errorf("syncing export: %w", err)
+	}
+
+	if err := e.tmp.Close(); err != nil {
+		return fmt.Errorf("closing export: %w", err)
+	}
+
+	if err := os.Rename(e.tmp.Name(), e.path); err != nil {
+		return fmt.Errorf("replacing export file %q: %w", e.path, err)
+	}
+
+	e.done = true
+
+	return nil
+}
+
+// Abort discards an uncommitted export leaving any existing
+// target untouched.
+func (e *logExporter) Abort() {
+	if e.done {
+		return
+	}
+
+	e.done = true
+
+	e.tmp.Close()
+	os.Remove(e.tmp.Name())
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogExporterResume(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	base := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	exp, err := newLogExporter(path, true)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.Write(exportEntry(t, "1", "cluster-a", base)))
+	require.NoError(t, exp.Write(exportEntry(t, "2", "cluster-a", base.Add(time.Hour))))
+	require.NoError(t, exp.Write(exportEntry(t, "3", "cluster-b", base)))
+	require.NoError(t, exp.Commit())
+
+	assert.Equal(t, 3, exp.Written())
+
+	resumed, err := newLogExporter(path, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, base.Add(time.Hour), resumed.ResumeFrom("cluster-a"))
+	assert.Equal(t, base, resumed.ResumeFrom("cluster-b"))
+	assert.True(t, resumed.ResumeFrom("cluster-c").IsZero())
+
+	require.NoError(t, resumed.Write(exportEntry(t, "2", "cluster-a", base.Add(time.Hour))))
+	require.NoError(t, resumed.Write(exportEntry(t, "4", "cluster-a", base.Add(2*time.Hour))))
+	require.NoError(t, resumed.Commit())
+
+	assert.Equal(t, 1, resumed.Written(), "should skip previously exported entries")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[3], `"id":"4"`)
+	assert.Contains(t, lines[3], `"timestamp":"2022-01-01T12:00:00Z"`)
+}
+
+func TestLogExporterResumeWithinSecond(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	base := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	exp, err := newLogExporter(path, true)
+	require.NoError(t, err)
+
+	for i, offset := range []time.Duration{100, 500, 900} {
+		require.NoError(t, exp.Write(exportEntry(t, fmt.Sprint(i+1), "cluster-a", base.Add(offset*time.Millisecond))))
+	}
+
+	require.NoError(t, exp.Commit())
+
+	resumed, err := newLogExporter(path, true)
+	require.NoError(t, err)
+
+	// Exported timestamps and timestamps in OCM search queries are
+	// truncated to seconds so resuming requests all entries of the
+	// last seen second again.
+	assert.Equal(t, base, resumed.ResumeFrom("cluster-a"))
+
+	for i, offset := range []time.Duration{100, 500, 900, 950} {
+		require.NoError(t, resumed.Write(exportEntry(t, fmt.Sprint(i+1), "cluster-a", base.Add(offset*time.Millisecond))))
+	}
+
+	// Entries may be returned twice if new entries shift the pages
+	// while they are requested.
+	require.NoError(t, resumed.Write(exportEntry(t, "4", "cluster-a", base.Add(950*time.Millisecond))))
+	require.NoError(t, resumed.Commit())
+
+	assert.Equal(t, 1, resumed.Written(), "should skip every previously exported entry of the same second")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[3], `"id":"4"`)
+}
+
+func TestLogExporterAbort(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs.jsonl")
+
+	require.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o600))
+
+	exp, err := newLogExporter(path, false)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.Write(exportEntry(t, "1", "cluster-a", time.Now())))
+	exp.Abort()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "existing\n", string(data), "should leave existing export untouched")
+
+	ents, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, ents, 1, "should remove temporary file")
+}
+
+func TestLogExporterPreservesMode(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	existing := filepath.Join(dir, "existing.jsonl")
+	require.NoError(t, os.WriteFile(existing, nil, 0o600))
+	require.NoError(t, os.Chmod(existing, 0o640))
+
+	reference, err := os.Create(filepath.Join(dir, "reference"))
+	require.NoError(t, err)
+	require.NoError(t, reference.Close())
+
+	for path, expected := range map[string]os.FileMode{
+		existing:                        0o640,
+		filepath.Join(dir, "new.jsonl"): fileMode(t, reference.Name()),
+	} {
+		exp, err := newLogExporter(path, false)
+		require.NoError(t, err)
+
+		require.NoError(t, exp.Write(exportEntry(t, "1", "cluster-a", time.Now())))
+		require.NoError(t, exp.Commit())
+
+		assert.Equal(t, expected, fileMode(t, path), path)
+	}
+}
+
+func fileMode(t *testing.T, path string) os.FileMode {
+	t.Helper()
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	return info.Mode().Perm()
+}
+
+func TestResumeRequiresExport(t *testing.T) {
+	t.Parallel()
+
+	opts := options{
+		Resume:    true,
+		bucketIn:  ocm.LogBucketDay,
+		groupByIn: ocm.LogGroupBySeverity,
+	}
+
+	require.ErrorIs(t, opts.ParseOptions(), errResumeWithoutExport)
+}
+
+func exportEntry(t *testing.T, id, clusterUUID string, ts time.Time) *ocm.LogEntry {
+	t.Helper()
+
+	entry, err := slv1.NewLogEntry().
+		ID(id).
+		ClusterUUID(clusterUUID).
+		Timestamp(ts).
+		Severity(slv1.SeverityInfo).
+		Summary("summary").
+		Build()
+	require.NoError(t, err)
+
+	return &ocm.LogEntry{Entry: entry}
+}
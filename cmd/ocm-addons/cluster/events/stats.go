@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+)
+
+const statsSummaryColumns = "count, severity, service_name, last_seen, summary"
+
+// writeStats aggregates the logs of all matching clusters and writes
+// a histogram of log events over time followed by a table of the most
+// frequent summaries.
+func writeStats(
+	ctx context.Context,
+	out io.Writer,
+	opts *options,
+	clusters *ocm.ClusterPager,
+	getLogsOpts ocm.GetLogsOptions,
+) error {
+	stats := ocm.NewLogStats(
+		ocm.LogStatsBucketSize(opts.Bucket),
+		ocm.LogStatsGroupBy(opts.GroupBy),
+	)
+
+	if err := clusters.ForEach(ctx, func(c *ocm.Cluster) error {
+		logs, err := c.GetLogs(ctx, getLogsOpts)
+		if err != nil {
+			return err
+		}
+
+		for _, l := range logs {
+			stats.Add(l)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if stats.Total() == 0 {
+		_, err := fmt.Fprintln(out, "no log events found")
+
+		return err
+	}
+
+	hist := cli.NewHistogram(
+		cli.WithNoColor(opts.NoColor),
+		cli.WithOutput{Out: out},
+	)
+
+	buckets, err := stats.Buckets()
+	if errors.Is(err, ocm.ErrTooManyBuckets) {
+		return fmt.Errorf("%w; use '--bucket day' or narrow the range with '--after' and '--before'", err)
+	} else if err != nil {
+		return err
+	}
+
+	if opts.Order == ocm.OrderDesc {
+		for i, j := 0, len(buckets)-1; i < j; i, j = i+1, j-1 {
+			buckets[i], buckets[j] = buckets[j], buckets[i]
+		}
+	}
+
+	for _, b := range buckets {
+		hist.Add(opts.Bucket.Format(b.Start), b.Total, bucketDetail(b))
+	}
+
+	if err := hist.Flush(); err != nil {
+		return fmt.Errorf("writing histogram: %w", err)
+	}
+
+	if _, err := fmt.Fprintln(out); err != nil {
+		return err
+	}
+
+	table, err := cli.NewTable(
+		cli.WithColumns(statsSummaryColumns),
+		cli.WithNoHeaders(opts.NoHeaders),
+		cli.WithNoColor(opts.NoColor),
+		cli.WithOutput{Out: out},
+	)
+	if err != nil {
+		return err
+	}
+
+	summaries := stats.TopSummaries(opts.Top)
+
+	for i := range summaries {
+		if err := table.Write(&summaries[i]); err != nil {
+			return err
+		}
+	}
+
+	return table.Flush()
+}
+
+func bucketDetail(b ocm.LogStatsBucket) string {
+	groups := b.Groups()
+
+	parts := make([]string, 0, len(groups))
+
+	for _, g := range groups {
+		parts = append(parts, fmt.Sprintf("%s: %d", g, b.Counts[g]))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -5,7 +5,9 @@
 package info
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/mt-sre/ocm-addons/internal/cli"
 	"github.com/mt-sre/ocm-addons/internal/ocm"
@@ -23,23 +25,36 @@ func Cmd() *cobra.Command {
 }
 
 type options struct {
+	cli.ClusterSelectionOptions
 	cli.CommonOptions
+	cli.ErrorOptions
+	cli.PagingOptions
 }
 
+const longDescription = `Retrieve cluster information including summary data related to add-ons.
+Add the 'limited_support_reasons' column to also list the active limited support
+reasons of each cluster.`
+
 func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "info [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH]",
 		Short: "retrieve cluster information",
-		Long:  "Retrieve cluster information including summary data related to add-ons.",
-		Args:  cobra.MinimumNArgs(1),
+		Long:  longDescription,
+		Args:  opts.ClusterArgs(cobra.MinimumNArgs(1)),
 		RunE:  run,
 	}
 
 	flags := cmd.Flags()
 
+	opts.AddClusterSearchFlag(flags)
 	opts.AddColumnsFlag(flags)
+	opts.AddContinueOnErrorFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddLimitFlag(flags)
 	opts.AddNoColorFlag(flags)
 	opts.AddNoHeadersFlag(flags)
+	opts.AddOrderByFlag(flags)
+	opts.AddPageSizeFlag(flags)
 
 	return cmd
 }
@@ -48,18 +63,23 @@ func run(opts *options) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
-		sess, err := cli.NewSession()
+		paging, err := opts.Paging()
+		if err != nil {
+			return err
+		}
+
+		sessions, err := cli.NewSessions()
 		if err != nil {
 			return fmt.Errorf("starting new session: %w", err)
 		}
 
-		defer sess.End()
+		defer cli.EndSessions(sessions)
 
 		table, err := cli.NewTable(
-			cli.WithColumns(opts.Columns),
+			cli.WithColumns(cli.EnvironmentColumns(opts.Columns, sessions)),
 			cli.WithNoColor(opts.NoColor),
 			cli.WithNoHeaders(opts.NoHeaders),
-			cli.WithPager(sess.Pager()),
+			cli.WithPager(sessions[0].Pager()),
 			cli.WithOutput{Out: cmd.OutOrStdout()},
 		)
 		if err != nil {
@@ -68,44 +88,95 @@ func run(opts *options) func(cmd *cobra.Command, args []string) error {
 
 		defer table.Flush()
 
-		search := args[0]
+		search, _ := opts.SplitClusterArg(args)
 
-		trace := sess.Logger().
-			WithFields(log.Fields{
-				"command": "cluster info",
-				"search":  search,
-			}).
-			Trace("running command")
-		defer trace.Stop(nil)
+		progress := cli.NewProgress(cmd.ErrOrStderr(), cli.WithPagedOutput(sessions[0].Pager() != ""))
+		defer progress.Stop()
 
-		clusters, err := ocm.RetrieveClusters(sess.Conn(), trace)
-		if err != nil {
-			return err
-		}
+		var failures error
 
-		matchingClusters := clusters.SearchByNameOrID(search)
+		for i := range sessions {
+			err := writeClusters(
+				ctx, &sessions[i], table, paging, opts.ClusterSelectionOptions, opts.Columns, search,
+				opts.ForEachOption(), progress.ForEachOption(),
+			)
 
-		err = matchingClusters.ForEach(ctx, func(cluster *ocm.Cluster) error {
-			cluster, err := cluster.WithSubscription(ctx)
-			if err != nil {
+			if err := opts.Collect(&failures, err); err != nil {
 				return err
 			}
+		}
 
-			cluster, err = cluster.WithAddonInstallations(ctx)
-			if err != nil {
-				return err
-			}
+		progress.Stop()
 
-			if err := table.Write(cluster); err != nil {
-				return fmt.Errorf("writing cluster to table: %w", err)
-			}
+		if err := table.Flush(); err != nil {
+			return err
+		}
+
+		return cli.ReportFailures(cmd.ErrOrStderr(), failures, cli.WithNoColor(opts.NoColor))
+	}
+}
 
-			return nil
-		})
+func writeClusters(
+	ctx context.Context,
+	sess *cli.Session,
+	table *cli.Table,
+	paging ocm.WithPaging,
+	selection cli.ClusterSelectionOptions,
+	columns, search string,
+	forEachOpts ...ocm.ForEachOption,
+) error {
+	requiresLimitedSupport := hasColumn(columns, "Limited Support Reasons")
+
+	trace := sess.Logger().
+		WithFields(log.Fields{
+			"command":       "cluster info",
+			"search":        search,
+			"clusterSearch": selection.ClusterSearch,
+		}).
+		Trace("running command")
+	defer trace.Stop(nil)
+
+	clusters, err := ocm.RetrieveClusters(
+		sess.Conn(), trace, sess.CacheOption(), sess.RetryOption(), paging, selection.ClusterOption(),
+	)
+	if err != nil {
+		return err
+	}
+
+	matchingClusters := selection.Select(clusters.SearchByNameOrID(search))
+
+	return matchingClusters.ForEach(ctx, func(cluster *ocm.Cluster) error {
+		cluster, err := cluster.WithSubscription(ctx)
 		if err != nil {
 			return err
 		}
 
+		cluster, err = cluster.WithAddonInstallations(ctx)
+		if err != nil {
+			return err
+		}
+
+		if requiresLimitedSupport {
+			cluster, err = cluster.WithLimitedSupportReasons(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := table.Write(cluster, sess.WithEnvironment()); err != nil {
+			return fmt.Errorf("writing cluster to table: %w", err)
+		}
+
 		return nil
+	}, forEachOpts...)
+}
+
+func hasColumn(columns, column string) bool {
+	for _, c := range strings.Split(columns, ",") {
+		if cli.Normalize(c) == cli.Normalize(column) {
+			return true
+		}
 	}
+
+	return false
 }
@@ -30,6 +30,11 @@ func TestCmdArguments(t *testing.T) {
 			args:    []string{"fake-cluster-name"},
 			reports: []interface{}{"should execute successfully"},
 		},
+		"cluster search flag without arguments": {
+			command: mockCommand(),
+			args:    []string{"--cluster-search", "cloud_provider.id = 'gcp' and region.id = 'us-east1'"},
+			reports: []interface{}{"should execute successfully"},
+		},
 	}
 
 	for name, test := range testCases {
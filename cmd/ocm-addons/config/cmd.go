@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/config/set"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/config/view"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return generateCommand()
+}
+
+func generateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config [command]",
+		Short: "manage the plug-in config",
+		Long: "Views and sets values of the plug-in config file which holds per-command defaults " +
+			"and environment profiles.",
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(view.Cmd())
+	cmd.AddCommand(set.Cmd())
+
+	return cmd
+}
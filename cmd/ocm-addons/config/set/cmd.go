@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package set
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	Command string
+}
+
+func (o *options) AddCommandFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Command,
+		"command",
+		o.Command,
+		"sets the default only for the given command, e.g. 'cluster events'; "+
+			"otherwise the default applies to all commands",
+	)
+}
+
+const _numArgs = 2
+
+const _example = `
+# Display timestamps in UTC for every command
+  ocm addons config set timeZone UTC
+
+# Change the default columns of 'ocm addons cluster events'
+  ocm addons config set columns "timestamp, severity, summary" --command "cluster events"
+
+# Remove the default concurrency of 'ocm addons notify'
+  ocm addons config set concurrency "" --command notify
+`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "set a default in the plug-in config",
+		Long: fmt.Sprintf(
+			"Sets the default of KEY, one of [%s], in the plug-in config file. An empty VALUE removes "+
+				"the default. Defaults are overridden by OCM_ADDONS_<KEY> and OCM_ADDONS_<COMMAND>_<KEY> "+
+				"environment variables and by command line flags.",
+			strings.Join(cli.DefaultKeys(), ", "),
+		),
+		Example: _example,
+		Args:    cobra.ExactArgs(_numArgs),
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddCommandFlag(flags)
+
+	return cmd
+}
+
+var errUnknownCommand = errors.New("unknown command")
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		key, value := args[0], args[1]
+
+		commandPath := strings.Join(strings.Fields(opts.Command), " ")
+
+		if commandPath != "" {
+			target, _, err := cmd.Root().Find(strings.Fields(commandPath))
+			if err != nil || cli.CommandPath(target) != commandPath {
+				return fmt.Errorf("%q: %w", opts.Command, errUnknownCommand)
+			}
+		}
+
+		path, err := cli.PluginConfigPath()
+		if err != nil {
+			return err
+		}
+
+		cfg, err := cli.LoadPluginConfigFile(path)
+		if err != nil {
+			return err
+		}
+
+		if err := setDefault(&cfg, commandPath, key, value); err != nil {
+			return err
+		}
+
+		if err := cfg.Save(path); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "updated %s\n", path)
+
+		return nil
+	}
+}
+
+// setDefault sets the key of either the global or the command's
+// defaults removing command entries which no longer set any key.
+func setDefault(cfg *cli.PluginConfig, commandPath, key, value string) error {
+	if commandPath == "" {
+		return cfg.Defaults.Set(key, value)
+	}
+
+	defaults := cfg.Commands[commandPath]
+
+	if err := defaults.Set(key, value); err != nil {
+		return err
+	}
+
+	if cfg.Commands == nil {
+		cfg.Commands = make(map[string]cli.CommandDefaults)
+	}
+
+	cfg.Commands[commandPath] = defaults
+
+	if defaults == (cli.CommandDefaults{}) {
+		delete(cfg.Commands, commandPath)
+	}
+
+	return nil
+}
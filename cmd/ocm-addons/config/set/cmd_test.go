@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package set
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command:     mockCommand(),
+			expectation: "accepts 2 arg(s), received 0",
+			reports:     []interface{}{"should fail expecting 2 args"},
+		},
+		"key and value": {
+			command: mockCommand(),
+			args:    []string{"pager", "less"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"key and value for command": {
+			command: mockCommand(),
+			args:    []string{"columns", "id", "--command", "cluster events"},
+			reports: []interface{}{"should execute successfully"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	t.Parallel()
+
+	var cfg cli.PluginConfig
+
+	require.NoError(t, setDefault(&cfg, "", "pager", "less"))
+	assert.Equal(t, "less", cfg.Defaults.Pager)
+
+	require.NoError(t, setDefault(&cfg, "cluster events", "order", "ascending"))
+	assert.Equal(t, "ascending", cfg.Commands["cluster events"].Order)
+
+	require.NoError(t, setDefault(&cfg, "cluster events", "order", ""))
+	assert.NotContains(t, cfg.Commands, "cluster events")
+
+	assert.Error(t, setDefault(&cfg, "notify", "concurrency", "none"))
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
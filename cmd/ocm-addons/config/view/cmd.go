@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package view
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	Command string
+}
+
+func (o *options) AddCommandFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Command,
+		"command",
+		o.Command,
+		"shows the effective defaults of a command, e.g. 'cluster events', "+
+			"including OCM_ADDONS_* environment variables",
+	)
+}
+
+const _example = `
+# Show the plug-in config file
+  ocm addons config view
+
+# Show the defaults which apply to 'ocm addons cluster events'
+  ocm addons config view --command "cluster events"
+`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "view",
+		Short:   "show the plug-in config",
+		Long:    "Shows the location and contents of the plug-in config file.",
+		Example: _example,
+		Args:    cobra.NoArgs,
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddCommandFlag(flags)
+
+	return cmd
+}
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		path, err := cli.PluginConfigPath()
+		if err != nil {
+			return err
+		}
+
+		cfg, err := cli.LoadPluginConfigFile(path)
+		if err != nil {
+			return err
+		}
+
+		var value interface{} = cfg
+
+		if opts.Command != "" {
+			commandPath := strings.Join(strings.Fields(opts.Command), " ")
+
+			defaults, err := cfg.CommandDefaults(commandPath, os.Getenv)
+			if err != nil {
+				return err
+			}
+
+			value = defaults
+		}
+
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("encoding plug-in config: %w", err)
+		}
+
+		fmt.Fprintf(out, "# %s\n", path)
+		fmt.Fprint(out, string(data))
+
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package view
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command: mockCommand(),
+			reports: []interface{}{"should execute successfully"},
+		},
+		"command flag": {
+			command: mockCommand(),
+			args:    []string{"--command", "cluster events"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"unexpected argument": {
+			command:     mockCommand(),
+			args:        []string{"pager"},
+			expectation: `unknown command "pager" for "view"`,
+			reports:     []interface{}{"should fail expecting no args"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
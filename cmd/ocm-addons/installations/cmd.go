@@ -5,9 +5,11 @@
 package installations
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/installations/status"
 	"github.com/mt-sre/ocm-addons/internal/cli"
 	"github.com/mt-sre/ocm-addons/internal/ocm"
 
@@ -24,7 +26,10 @@ func Cmd() *cobra.Command {
 }
 
 type options struct {
+	cli.ClusterSelectionOptions
 	cli.CommonOptions
+	cli.ErrorOptions
+	cli.PagingOptions
 }
 
 const longDescription = `List all installations of a given add-on by cluster in the current OCM environment.
@@ -32,37 +37,52 @@ If no argument is provided all installations of all addo-ons will be listed.`
 
 func generateCommand(options *options, run func(*cobra.Command, []string) error) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "installations [ADDON_ID|ADDON_NAME|ADDON_NAME_SEARCH]",
-		Short: "list all installations of a given add-on",
-		Long:  longDescription,
-		RunE:  run,
+		Use:     "installations [ADDON_ID|ADDON_NAME|ADDON_NAME_SEARCH]",
+		Aliases: []string{"installation"},
+		Short:   "list all installations of a given add-on",
+		Long:    longDescription,
+		Args:    cobra.ArbitraryArgs,
+		RunE:    run,
 	}
 
+	cmd.AddCommand(status.Cmd())
+
 	flags := cmd.Flags()
 
+	options.AddClusterSearchFlag(flags)
 	options.AddColumnsFlag(flags)
+	options.AddContinueOnErrorFlag(flags)
+	options.AddIncludeUnmanagedFlag(flags)
+	options.AddLimitFlag(flags)
 	options.AddNoColorFlag(flags)
 	options.AddNoHeadersFlag(flags)
+	options.AddOrderByFlag(flags)
+	options.AddPageSizeFlag(flags)
 
 	return cmd
 }
 
-func run(opts *options) func(cmd *cobra.Command, args []string) error { //nolint: cyclop
+func run(opts *options) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
-		sess, err := cli.NewSession()
+		paging, err := opts.Paging()
+		if err != nil {
+			return err
+		}
+
+		sessions, err := cli.NewSessions()
 		if err != nil {
 			return fmt.Errorf("starting new session: %w", err)
 		}
 
-		defer sess.End()
+		defer cli.EndSessions(sessions)
 
 		table, err := cli.NewTable(
-			cli.WithColumns(opts.Columns),
+			cli.WithColumns(cli.EnvironmentColumns(opts.Columns, sessions)),
 			cli.WithNoColor(opts.NoColor),
 			cli.WithNoHeaders(opts.NoHeaders),
-			cli.WithPager(sess.Pager()),
+			cli.WithPager(sessions[0].Pager()),
 			cli.WithOutput{Out: cmd.OutOrStdout()},
 		)
 		if err != nil {
@@ -71,59 +91,96 @@ func run(opts *options) func(cmd *cobra.Command, args []string) error { //nolint
 
 		defer table.Flush()
 
-		requiresSub := hasSubscriptionField(opts.Columns)
-
 		var pattern string
 
 		if len(args) > 0 {
 			pattern = args[0]
 		}
 
-		trace := sess.Logger().
-			WithFields(log.Fields{
-				"command": "installations",
-				"search":  pattern,
-			}).
-			Trace("running command")
-		defer trace.Stop(nil)
+		progress := cli.NewProgress(cmd.ErrOrStderr(), cli.WithPagedOutput(sessions[0].Pager() != ""))
+		defer progress.Stop()
 
-		clusters, err := ocm.RetrieveClusters(sess.Conn(), trace)
-		if err != nil {
+		var failures error
+
+		for i := range sessions {
+			err := writeInstallations(
+				ctx, &sessions[i], table, paging, opts.ClusterSelectionOptions, opts.Columns, pattern,
+				opts.ForEachOption(), progress.ForEachOption(),
+			)
+
+			if err := opts.Collect(&failures, err); err != nil {
+				return err
+			}
+		}
+
+		progress.Stop()
+
+		if err := table.Flush(); err != nil {
 			return err
 		}
 
-		if err := clusters.ForEach(ctx, func(cluster *ocm.Cluster) error {
-			cluster, err := cluster.WithAddonInstallations(ctx)
-			if err != nil {
-				return fmt.Errorf("retrieving installations for cluster: %w", err)
-			}
+		return cli.ReportFailures(cmd.ErrOrStderr(), failures, cli.WithNoColor(opts.NoColor))
+	}
+}
 
-			if requiresSub {
-				cluster, err = cluster.WithSubscription(ctx)
-				if err != nil {
-					return err
-				}
-			}
+func writeInstallations(
+	ctx context.Context,
+	sess *cli.Session,
+	table *cli.Table,
+	paging ocm.WithPaging,
+	selection cli.ClusterSelectionOptions,
+	columns, pattern string,
+	forEachOpts ...ocm.ForEachOption,
+) error {
+	requiresSub := hasSubscriptionField(columns)
+
+	trace := sess.Logger().
+		WithFields(log.Fields{
+			"command":       "installations",
+			"search":        pattern,
+			"clusterSearch": selection.ClusterSearch,
+		}).
+		Trace("running command")
+	defer trace.Stop(nil)
+
+	clusters, err := ocm.RetrieveClusters(
+		sess.Conn(), trace, sess.CacheOption(), sess.RetryOption(), paging, selection.ClusterOption(),
+	)
+	if err != nil {
+		return err
+	}
 
-			addons := cluster.AddonInstallations
+	if err := selection.Select(clusters).ForEach(ctx, func(cluster *ocm.Cluster) error {
+		cluster, err := cluster.WithAddonInstallations(ctx)
+		if err != nil {
+			return fmt.Errorf("retrieving installations for cluster: %w", err)
+		}
 
-			if pattern != "" {
-				addons = addons.Matching(pattern)
+		if requiresSub {
+			cluster, err = cluster.WithSubscription(ctx)
+			if err != nil {
+				return err
 			}
+		}
 
-			for i := range addons {
-				if err := table.Write(&addons[i]); err != nil {
-					return err
-				}
-			}
+		addons := cluster.AddonInstallations
 
-			return nil
-		}); err != nil {
-			return fmt.Errorf("processing clusters: %w", err)
+		if pattern != "" {
+			addons = addons.Matching(pattern)
+		}
+
+		for i := range addons {
+			if err := table.Write(&addons[i], sess.WithEnvironment()); err != nil {
+				return err
+			}
 		}
 
 		return nil
+	}, forEachOpts...); err != nil {
+		return fmt.Errorf("processing clusters: %w", err)
 	}
+
+	return nil
 }
 
 func hasSubscriptionField(columns string) bool {
@@ -65,6 +65,14 @@ func TestCmdOptions(t *testing.T) {
 			},
 			reports: []interface{}{"should execute successfully"},
 		},
+		"continue on error flag": {
+			command: mockCommand(),
+			args: []string{
+				"--continue-on-error",
+				"fake-addon-name",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
 		"no color flag": {
 			command: mockCommand(),
 			args: []string{
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/apex/log"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	cli.ClusterSelectionOptions
+	cli.CommonOptions
+	cli.ErrorOptions
+	cli.PagingOptions
+}
+
+const _numArgs = 2
+
+const longDescription = `Display the detailed status of an add-on installation on the matching clusters.
+This includes each status condition reported by the add-on, the fulfilment of
+each add-on requirement for the cluster and each sub-operator.`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH] ADDON_ID",
+		Short: "display the status of an add-on installation",
+		Long:  longDescription,
+		Args:  opts.ClusterArgs(cobra.ExactArgs(_numArgs)),
+		RunE:  run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddClusterSearchFlag(flags)
+	opts.AddContinueOnErrorFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddLimitFlag(flags)
+	opts.AddNoColorFlag(flags)
+	opts.AddOrderByFlag(flags)
+	opts.AddPageSizeFlag(flags)
+
+	return cmd
+}
+
+const (
+	conditionColumns   = "type, status, reason, message"
+	requirementColumns = "id, resource, status_fulfilled, status_error_messages"
+	subOperatorColumns = "operator_name, operator_namespace, enabled"
+)
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var (
+			ctx = cmd.Context()
+			out = cmd.OutOrStdout()
+		)
+
+		paging, err := opts.Paging()
+		if err != nil {
+			return err
+		}
+
+		sess, err := cli.NewSession()
+		if err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
+
+		defer sess.End()
+
+		search, rest := opts.SplitClusterArg(args)
+		addonID := rest[0]
+
+		trace := sess.Logger().
+			WithFields(log.Fields{
+				"command":       "installations status",
+				"search":        search,
+				"addon":         addonID,
+				"clusterSearch": opts.ClusterSearch,
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		clusters, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), paging, opts.ClusterOption())
+		if err != nil {
+			return fmt.Errorf("retrieving clusters: %w", err)
+		}
+
+		var failures error
+
+		err = opts.Select(clusters.SearchByNameOrID(search)).ForEach(ctx, func(c *ocm.Cluster) error {
+			status, err := c.AddonInstallationStatus(ctx, addonID)
+			if errors.Is(err, ocm.ErrAddonNotInstalled) {
+				return writeNotInstalled(out, c, addonID)
+			} else if err != nil {
+				return fmt.Errorf("retrieving status for cluster %q: %w", c.ID(), err)
+			}
+
+			return writeStatus(out, opts, c, addonID, &status)
+		}, opts.ForEachOption())
+
+		if err := opts.Collect(&failures, err); err != nil {
+			return err
+		}
+
+		return cli.ReportFailures(cmd.ErrOrStderr(), failures, cli.WithNoColor(opts.NoColor))
+	}
+}
+
+func writeStatus(out io.Writer, opts *options, c *ocm.Cluster, addonID string, status *ocm.AddonInstallationStatus) error {
+	fmt.Fprintf(out, "Cluster: %s (%s)\n", c.Name(), c.ID())
+	fmt.Fprintf(out, "Add-on: %s\n", addonID)
+	fmt.Fprintf(out, "Version: %s\n", status.VersionID())
+	fmt.Fprintf(out, "State: %s\n", status.State())
+	fmt.Fprintf(out, "State Description: %s\n", status.StateDescription())
+
+	if unfulfilled := len(status.UnfulfilledRequirements()); unfulfilled > 0 {
+		fmt.Fprintf(out, "Unfulfilled Requirements: %d\n", unfulfilled)
+	}
+
+	conditions := make([]cli.RowDataProvider, 0, len(status.Conditions))
+	for i := range status.Conditions {
+		conditions = append(conditions, &status.Conditions[i])
+	}
+
+	if err := writeSection(out, opts, "Conditions", conditionColumns, conditions); err != nil {
+		return err
+	}
+
+	requirements := make([]cli.RowDataProvider, 0, len(status.Requirements))
+	for i := range status.Requirements {
+		requirements = append(requirements, &status.Requirements[i])
+	}
+
+	if err := writeSection(out, opts, "Requirements", requirementColumns, requirements); err != nil {
+		return err
+	}
+
+	subOperators := make([]cli.RowDataProvider, 0, len(status.SubOperators))
+	for i := range status.SubOperators {
+		subOperators = append(subOperators, &status.SubOperators[i])
+	}
+
+	if err := writeSection(out, opts, "Sub-Operators", subOperatorColumns, subOperators); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintln(out)
+
+	return err
+}
+
+// writeNotInstalled reports that the add-on is not installed on a
+// cluster matching the search so that other clusters can be processed.
+func writeNotInstalled(out io.Writer, c *ocm.Cluster, addonID string) error {
+	fmt.Fprintf(out, "Cluster: %s (%s)\n", c.Name(), c.ID())
+	fmt.Fprintf(out, "Add-on: %s\n", addonID)
+
+	_, err := fmt.Fprint(out, "State: not installed\n\n")
+
+	return err
+}
+
+func writeSection(out io.Writer, opts *options, title, columns string, rows []cli.RowDataProvider) error {
+	fmt.Fprintf(out, "\n%s:\n", title)
+
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(out, "  none reported")
+
+		return err
+	}
+
+	table, err := cli.NewTable(
+		cli.WithColumns(columns),
+		cli.WithNoColor(opts.NoColor),
+		cli.WithOutput{Out: out},
+	)
+	if err != nil {
+		return fmt.Errorf("creating table: %w", err)
+	}
+
+	for _, row := range rows {
+		if err := table.Write(row); err != nil {
+			return fmt.Errorf("writing table row: %w", err)
+		}
+	}
+
+	return table.Flush()
+}
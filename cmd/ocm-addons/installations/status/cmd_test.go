@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"less than 2 arguments": {
+			command:     mockCommand(),
+			args:        []string{"fake-cluster-name"},
+			expectation: fmt.Sprintf("accepts %d arg(s), received 1", _numArgs),
+			reports:     []interface{}{fmt.Sprintf("should fail expecting %d args", _numArgs)},
+		},
+		"two arguments": {
+			command: mockCommand(),
+			args:    []string{"fake-cluster-name", "fake-addon-id"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"cluster search flag instead of cluster argument": {
+			command: mockCommand(),
+			args:    []string{"--cluster-search", "region.id = 'us-east1'", "fake-addon-id"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"include unmanaged flag": {
+			command: mockCommand(),
+			args:    []string{"--include-unmanaged", "fake-cluster-name", "fake-addon-id"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"no color flag": {
+			command: mockCommand(),
+			args:    []string{"--no-color", "fake-cluster-name", "fake-addon-id"},
+			reports: []interface{}{"should execute successfully"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
+
+func TestWriteNotInstalled(t *testing.T) {
+	t.Parallel()
+
+	cluster, err := cmv1.NewCluster().ID("cluster-id").Name("cluster-name").Build()
+	require.NoError(t, err)
+
+	c := ocm.NewCluster(cluster)
+
+	var out bytes.Buffer
+
+	require.NoError(t, writeNotInstalled(&out, &c, "addon-id"))
+	require.Equal(t, "Cluster: cluster-name (cluster-id)\nAdd-on: addon-id\nState: not installed\n\n", out.String())
+}
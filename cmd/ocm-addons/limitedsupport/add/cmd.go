@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package add
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/limitedsupport"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/apex/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	cli.ClusterSelectionOptions
+	TemplateID string
+}
+
+func (o *options) AddTemplateFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.TemplateID,
+		"template",
+		o.TemplateID,
+		"id of an OCM limited support reason template to use instead of a catalog reason",
+	)
+}
+
+const _maxArgs = 2
+
+const _example = `
+# Placing a cluster into limited support using the following catalog reason:
+# Team:              "example-team"
+# Product:           "example-product"
+# Reason Config ID:  "example-reason"
+  ocm addons limited-support add example-cluster example-team/example-product/example-reason
+
+# Placing a cluster into limited support using an OCM reason template
+  ocm addons limited-support add example-cluster --template example-template
+
+# Placing all GCP clusters in a region into limited support
+  ocm addons limited-support add --cluster-search "cloud_provider.id = 'gcp' and region.id = 'us-east1'" \
+    --template example-template
+`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH] [REASON_ID]",
+		Example: _example,
+		Short:   "place clusters into limited support",
+		Long:    "Place clusters into limited support using a reason from the catalog or an OCM reason template.",
+		Args:    opts.ClusterArgs(cobra.RangeArgs(1, _maxArgs)),
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddClusterSearchFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddTemplateFlag(flags)
+
+	return cmd
+}
+
+var errReasonOrTemplateRequired = errors.New("exactly one of a REASON_ID or '--template' is required")
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var (
+			ctx = cmd.Context()
+			in  = cmd.InOrStdin()
+			out = cmd.OutOrStdout()
+		)
+
+		search, rest := opts.SplitClusterArg(args)
+
+		var reasonID string
+
+		if len(rest) > 0 {
+			reasonID = rest[0]
+		}
+
+		if (reasonID != "") == (opts.TemplateID != "") {
+			return errReasonOrTemplateRequired
+		}
+
+		reasonOpts := []ocm.LimitedSupportReasonOption{
+			ocm.LimitedSupportReasonTemplateID(opts.TemplateID),
+		}
+
+		if reasonID != "" {
+			cfg, err := getReasonConfig(reasonID)
+			if err != nil {
+				return fmt.Errorf("getting limited support reason %q: %w", reasonID, err)
+			}
+
+			reasonOpts = []ocm.LimitedSupportReasonOption{
+				ocm.LimitedSupportReasonSummary(cfg.Summary),
+				ocm.LimitedSupportReasonDetails(cfg.Details),
+			}
+		}
+
+		sess, err := cli.NewSession()
+		if err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
+
+		defer sess.End()
+
+		trace := sess.Logger().
+			WithFields(log.Fields{
+				"command":       "limited-support add",
+				"search":        search,
+				"template":      opts.TemplateID,
+				"clusterSearch": opts.ClusterSearch,
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		clusters, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), opts.ClusterOption())
+		if err != nil {
+			return fmt.Errorf("retrieving clusters: %w", err)
+		}
+
+		return opts.Select(clusters.SearchByNameOrID(search)).ForEach(ctx, func(c *ocm.Cluster) error {
+			fmt.Fprintf(out, "Cluster ID: %s\n", c.ID())
+			fmt.Fprintf(out, "Cluster Name: %s\n", c.Name())
+
+			if reasonID != "" {
+				fmt.Fprintf(out, "Reason: %s\n", reasonID)
+			} else {
+				fmt.Fprintf(out, "Template: %s\n", opts.TemplateID)
+			}
+
+			if !cli.PromptYesOrNo(out, in, "Please confirm before placing this cluster into limited support") {
+				fmt.Fprintln(out, "limited support reason cancelled")
+
+				return nil
+			}
+
+			reason, err := c.AddLimitedSupportReason(ctx, reasonOpts...)
+			if err != nil {
+				return fmt.Errorf("failed to add limited support reason: %w", err)
+			}
+
+			fmt.Fprintf(out, "limited support reason %q added successfully\n", reason.ID())
+
+			return nil
+		})
+	}
+}
+
+var (
+	errInvalidReasonID = errors.New("invalid reason ID")
+	errReasonNotFound  = errors.New("reason not found")
+)
+
+func getReasonConfig(rawID string) (limitedsupport.Config, error) {
+	const numParts = 3
+
+	parsed := strings.SplitN(rawID, "/", numParts)
+
+	if len(parsed) < numParts {
+		return limitedsupport.Config{}, errInvalidReasonID
+	}
+
+	team, product, id := parsed[0], parsed[1], parsed[2]
+
+	cfg, ok := limitedsupport.GetReason(team, product, id)
+	if !ok {
+		return limitedsupport.Config{}, errReasonNotFound
+	}
+
+	return cfg, nil
+}
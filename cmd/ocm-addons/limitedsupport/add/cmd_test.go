@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package add
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command:     mockCommand(),
+			expectation: "accepts between 1 and 2 arg(s), received 0",
+			reports:     []interface{}{"should report missing argument"},
+		},
+		"cluster and reason": {
+			command: mockCommand(),
+			args:    []string{"fake-cluster-name", "fake-team/fake-product/fake-reason"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"cluster and template flag": {
+			command: mockCommand(),
+			args:    []string{"--template", "fake-template", "fake-cluster-name"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"too many arguments": {
+			command:     mockCommand(),
+			args:        []string{"one", "two", "three"},
+			expectation: "accepts between 1 and 2 arg(s), received 3",
+			reports:     []interface{}{"should report too many arguments"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func TestGetReasonConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := getReasonConfig("invalid")
+	require.ErrorIs(t, err, errInvalidReasonID)
+
+	_, err = getReasonConfig("fake-team/fake-product/fake-reason")
+	require.ErrorIs(t, err, errReasonNotFound)
+
+	cfg, err := getReasonConfig("mtsre/ocs-converged/ceph-osd-full")
+	require.NoError(t, err)
+	require.NotEmpty(t, cfg.Summary)
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
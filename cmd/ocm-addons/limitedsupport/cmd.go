@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package limitedsupport
+
+import (
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/limitedsupport/add"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/limitedsupport/list"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/limitedsupport/remove"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return generateCommand()
+}
+
+func generateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limited-support [command]",
+		Short: "manage cluster limited support reasons",
+		Long:  "Lists, adds and removes limited support reasons for clusters with add-on related issues.",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(list.Cmd())
+	cmd.AddCommand(add.Cmd())
+	cmd.AddCommand(remove.Cmd())
+
+	return cmd
+}
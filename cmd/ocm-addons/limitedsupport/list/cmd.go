@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/apex/log"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	opts.DefaultColumns("cluster_id, cluster_name, id, summary, detection_type, creation_timestamp")
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	cli.ClusterSelectionOptions
+	cli.CommonOptions
+	cli.ErrorOptions
+	cli.PagingOptions
+}
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH]",
+		Aliases: []string{"ls"},
+		Short:   "list active limited support reasons",
+		Long:    "List the limited support reasons which are active for the matching clusters.",
+		Args:    opts.ClusterArgs(cobra.ExactArgs(1)),
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddClusterSearchFlag(flags)
+	opts.AddColumnsFlag(flags)
+	opts.AddContinueOnErrorFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddLimitFlag(flags)
+	opts.AddNoColorFlag(flags)
+	opts.AddNoHeadersFlag(flags)
+	opts.AddOrderByFlag(flags)
+	opts.AddPageSizeFlag(flags)
+
+	return cmd
+}
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		paging, err := opts.Paging()
+		if err != nil {
+			return err
+		}
+
+		sess, err := cli.NewSession()
+		if err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
+
+		defer sess.End()
+
+		table, err := cli.NewTable(
+			cli.WithColumns(opts.Columns),
+			cli.WithNoColor(opts.NoColor),
+			cli.WithNoHeaders(opts.NoHeaders),
+			cli.WithPager(sess.Pager()),
+			cli.WithOutput{Out: cmd.OutOrStdout()},
+		)
+		if err != nil {
+			return fmt.Errorf("creating table: %w", err)
+		}
+
+		defer table.Flush()
+
+		search, _ := opts.SplitClusterArg(args)
+
+		trace := sess.Logger().
+			WithFields(log.Fields{
+				"command":       "limited-support list",
+				"search":        search,
+				"clusterSearch": opts.ClusterSearch,
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		clusters, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), paging, opts.ClusterOption())
+		if err != nil {
+			return fmt.Errorf("retrieving clusters: %w", err)
+		}
+
+		var failures error
+
+		err = opts.Select(clusters.SearchByNameOrID(search)).ForEach(ctx, func(c *ocm.Cluster) error {
+			c, err := c.WithLimitedSupportReasons(ctx)
+			if err != nil {
+				return err
+			}
+
+			for i := range c.LimitedSupportReasons {
+				if err := table.Write(&c.LimitedSupportReasons[i], cli.WithAdditionalFields(
+					map[string]interface{}{
+						"Cluster ID":   c.ID(),
+						"Cluster Name": c.Name(),
+					},
+				)); err != nil {
+					return fmt.Errorf("writing table row: %w", err)
+				}
+			}
+
+			return nil
+		}, opts.ForEachOption())
+
+		if err := opts.Collect(&failures, err); err != nil {
+			return err
+		}
+
+		if err := table.Flush(); err != nil {
+			return err
+		}
+
+		return cli.ReportFailures(cmd.ErrOrStderr(), failures, cli.WithNoColor(opts.NoColor))
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command:     mockCommand(),
+			expectation: "accepts 1 arg(s), received 0",
+			reports:     []interface{}{"should report missing argument"},
+		},
+		"single argument": {
+			command: mockCommand(),
+			args:    []string{"fake-cluster-name"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"cluster search flag instead of cluster argument": {
+			command: mockCommand(),
+			args:    []string{"--cluster-search", "cloud_provider.id = 'gcp'"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"columns flag with single argument": {
+			command: mockCommand(),
+			args:    []string{"--columns", "one,two", "fake-cluster-name"},
+			reports: []interface{}{"should execute successfully"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
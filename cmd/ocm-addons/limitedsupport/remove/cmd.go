@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package remove
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/apex/log"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	cli.ClusterSelectionOptions
+}
+
+const _numArgs = 2
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH] LIMITED_SUPPORT_REASON_ID",
+		Aliases: []string{"rm"},
+		Short:   "remove a limited support reason",
+		Long:    "Remove an active limited support reason from the matching clusters.",
+		Args:    opts.ClusterArgs(cobra.ExactArgs(_numArgs)),
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddClusterSearchFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+
+	return cmd
+}
+
+var errReasonNotFound = errors.New("limited support reason not found on any matching cluster")
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var (
+			ctx = cmd.Context()
+			in  = cmd.InOrStdin()
+			out = cmd.OutOrStdout()
+		)
+
+		sess, err := cli.NewSession()
+		if err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
+
+		defer sess.End()
+
+		search, rest := opts.SplitClusterArg(args)
+		reasonID := rest[0]
+
+		trace := sess.Logger().
+			WithFields(log.Fields{
+				"command":       "limited-support remove",
+				"search":        search,
+				"reason":        reasonID,
+				"clusterSearch": opts.ClusterSearch,
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		clusters, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), opts.ClusterOption())
+		if err != nil {
+			return fmt.Errorf("retrieving clusters: %w", err)
+		}
+
+		var found bool
+
+		if err := opts.Select(clusters.SearchByNameOrID(search)).ForEach(ctx, func(c *ocm.Cluster) error {
+			c, err := c.WithLimitedSupportReasons(ctx)
+			if err != nil {
+				return err
+			}
+
+			var reason *ocm.LimitedSupportReason
+
+			for i := range c.LimitedSupportReasons {
+				if c.LimitedSupportReasons[i].ID() == reasonID {
+					reason = &c.LimitedSupportReasons[i]
+				}
+			}
+
+			if reason == nil {
+				trace.WithField("cluster", c.ID()).Debug("limited support reason not found")
+
+				return nil
+			}
+
+			found = true
+
+			fmt.Fprintf(out, "Cluster ID: %s\n", c.ID())
+			fmt.Fprintf(out, "Cluster Name: %s\n", c.Name())
+			fmt.Fprintf(out, "Summary: %s\n", reason.Summary())
+
+			if !cli.PromptYesOrNo(out, in, "Please confirm before removing this limited support reason") {
+				fmt.Fprintln(out, "removal cancelled")
+
+				return nil
+			}
+
+			if err := c.RemoveLimitedSupportReason(ctx, reasonID); err != nil {
+				return fmt.Errorf("failed to remove limited support reason: %w", err)
+			}
+
+			fmt.Fprintln(out, "limited support reason removed successfully")
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("%q: %w", reasonID, errReasonNotFound)
+		}
+
+		return nil
+	}
+}
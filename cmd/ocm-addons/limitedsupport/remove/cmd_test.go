@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package remove
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"less than 2 arguments": {
+			command:     mockCommand(),
+			args:        []string{"fake-cluster-name"},
+			expectation: fmt.Sprintf("accepts %d arg(s), received 1", _numArgs),
+			reports:     []interface{}{fmt.Sprintf("should fail expecting %d args", _numArgs)},
+		},
+		"two arguments": {
+			command: mockCommand(),
+			args:    []string{"fake-cluster-name", "fake-reason-id"},
+			reports: []interface{}{"should execute successfully"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
@@ -5,6 +5,7 @@
 package list
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/mt-sre/ocm-addons/internal/cli"
@@ -25,6 +26,8 @@ func Cmd() *cobra.Command {
 
 type options struct {
 	cli.CommonOptions
+	cli.ErrorOptions
+	cli.PagingOptions
 	cli.SearchOptions
 }
 
@@ -40,8 +43,12 @@ func generateCommand(opts *options, run func(*cobra.Command, []string) error) *c
 	flags := cmd.Flags()
 
 	opts.AddColumnsFlag(flags)
+	opts.AddContinueOnErrorFlag(flags)
+	opts.AddLimitFlag(flags)
 	opts.AddNoColorFlag(flags)
 	opts.AddNoHeadersFlag(flags)
+	opts.AddOrderByFlag(flags)
+	opts.AddPageSizeFlag(flags)
 	opts.AddSearchFlag(flags)
 
 	return cmd
@@ -51,18 +58,23 @@ func run(opts *options) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
-		sess, err := cli.NewSession()
+		paging, err := opts.Paging()
+		if err != nil {
+			return err
+		}
+
+		sessions, err := cli.NewSessions()
 		if err != nil {
 			return fmt.Errorf("starting session: %w", err)
 		}
 
-		defer sess.End()
+		defer cli.EndSessions(sessions)
 
 		table, err := cli.NewTable(
-			cli.WithColumns(opts.Columns),
+			cli.WithColumns(cli.EnvironmentColumns(opts.Columns, sessions)),
 			cli.WithNoColor(opts.NoColor),
 			cli.WithNoHeaders(opts.NoHeaders),
-			cli.WithPager(sess.Pager()),
+			cli.WithPager(sessions[0].Pager()),
 			cli.WithOutput{Out: cmd.OutOrStdout()},
 		)
 		if err != nil {
@@ -71,36 +83,61 @@ func run(opts *options) func(cmd *cobra.Command, args []string) error {
 
 		defer table.Flush()
 
-		trace := sess.Logger().
-			WithFields(log.Fields{
-				"command": "list",
-			}).
-			Trace("running command")
-		defer trace.Stop(nil)
+		var failures error
 
-		addons, err := ocm.RetrieveAddons(sess.Conn(), trace)
-		if err != nil {
-			return fmt.Errorf("retrieving addons: %w", err)
+		for i := range sessions {
+			err := writeAddons(ctx, &sessions[i], table, paging, opts.Search, opts.ForEachOption())
+
+			if err := opts.Collect(&failures, err); err != nil {
+				return err
+			}
 		}
 
-		matchingAddons := addons.SearchByNameOrID(opts.Search)
+		if err := table.Flush(); err != nil {
+			return err
+		}
 
-		err = matchingAddons.ForEach(ctx, func(a *ocm.Addon) error {
-			addon, err := a.WithVersion(ctx)
-			if err != nil {
-				return fmt.Errorf("retrieving addon version: %w", err)
-			}
+		return cli.ReportFailures(cmd.ErrOrStderr(), failures, cli.WithNoColor(opts.NoColor))
+	}
+}
 
-			if err := table.Write(addon); err != nil {
-				return fmt.Errorf("writing table row: %w", err)
-			}
+func writeAddons(
+	ctx context.Context,
+	sess *cli.Session,
+	table *cli.Table,
+	paging ocm.WithPaging,
+	search string,
+	forEachOpts ...ocm.ForEachOption,
+) error {
+	trace := sess.Logger().
+		WithFields(log.Fields{
+			"command": "list",
+		}).
+		Trace("running command")
+	defer trace.Stop(nil)
+
+	addons, err := ocm.RetrieveAddons(sess.Conn(), trace, sess.CacheOption(), sess.RetryOption(), paging)
+	if err != nil {
+		return fmt.Errorf("retrieving addons: %w", err)
+	}
 
-			return nil
-		})
+	matchingAddons := addons.SearchByNameOrID(search)
+
+	err = matchingAddons.ForEach(ctx, func(a *ocm.Addon) error {
+		addon, err := a.WithVersion(ctx)
 		if err != nil {
-			return fmt.Errorf("populating table: %w", err)
+			return fmt.Errorf("retrieving addon version: %w", err)
+		}
+
+		if err := table.Write(addon, sess.WithEnvironment()); err != nil {
+			return fmt.Errorf("writing table row: %w", err)
 		}
 
 		return nil
+	}, forEachOpts...)
+	if err != nil {
+		return fmt.Errorf("populating table: %w", err)
 	}
+
+	return nil
 }
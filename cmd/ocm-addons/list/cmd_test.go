@@ -98,6 +98,19 @@ func TestCmdOptions(t *testing.T) {
 			},
 			reports: []interface{}{"should execute successfully"},
 		},
+		"paging flags": {
+			command: mockCommand(),
+			args: []string{
+				"--page-size", "100", "--limit", "10", "--order-by", "name desc",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"page size flag with invalid argument": {
+			command:     mockCommand(),
+			args:        []string{"--page-size", "many"},
+			expectation: "invalid argument",
+			reports:     []interface{}{"should report invalid option argument"},
+		},
 	}
 
 	for name, test := range testcases {
@@ -12,8 +12,11 @@ import (
 
 	"github.com/apex/log"
 	apexcli "github.com/apex/log/handlers/cli"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/cache"
 	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/cluster"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/config"
 	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/installations"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/limitedsupport"
 	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/list"
 	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/notify"
 	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/update"
@@ -37,15 +40,48 @@ func main() {
 	}()
 
 	rootCmd := generateRootCmd()
-	if err := rootCmd.ExecuteContext(ctx); err != nil && !errors.Is(err, context.Canceled) {
+
+	if err := applyDefaults(rootCmd, os.Args[1:]); err != nil {
 		log.
 			WithError(err).
-			Error("ocm addons exited unexpectedly")
+			Error("unable to apply plug-in config")
 
 		code = 1
+
+		return
+	}
+
+	err := rootCmd.ExecuteContext(ctx)
+
+	switch code = exitCode(err); code {
+	case 0:
+	case _exitPartialFailure:
+		log.
+			WithError(err).
+			Error("ocm addons completed with failures")
+	default:
+		log.
+			WithError(err).
+			Error("ocm addons exited unexpectedly")
 	}
 }
 
+// exitCode returns the exit status for the error returned by a command.
+func exitCode(err error) int {
+	switch {
+	case err == nil, errors.Is(err, context.Canceled):
+		return 0
+	case errors.Is(err, cli.ErrPartialFailure):
+		return _exitPartialFailure
+	default:
+		return 1
+	}
+}
+
+// _exitPartialFailure is the exit status of commands which continued
+// past errors for individual clusters or add-ons.
+const _exitPartialFailure = 2
+
 func generateRootCmd() *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:           "ocm addons [command]",
@@ -55,8 +91,11 @@ func generateRootCmd() *cobra.Command {
 		SilenceUsage:  true,
 	}
 
+	rootCmd.AddCommand(cache.Cmd())
 	rootCmd.AddCommand(cluster.Cmd())
+	rootCmd.AddCommand(config.Cmd())
 	rootCmd.AddCommand(installations.Cmd())
+	rootCmd.AddCommand(limitedsupport.Cmd())
 	rootCmd.AddCommand(list.Cmd())
 	rootCmd.AddCommand(notify.Cmd())
 	rootCmd.AddCommand(update.Cmd())
@@ -71,11 +110,63 @@ func generateRootCmd() *cobra.Command {
 		"increase logging verbosity; '-vvv' for max verbosity",
 	)
 
+	cli.GlobalSessionOptions.AddEnvFlag(flags)
+	cli.GlobalSessionOptions.AddTokenFlag(flags)
+	cli.GlobalSessionOptions.AddURLFlag(flags)
+	cli.GlobalCacheOptions.AddCacheTTLFlag(flags)
+	cli.GlobalCacheOptions.AddNoCacheFlag(flags)
+	cli.GlobalCacheOptions.AddRefreshFlag(flags)
+	cli.GlobalRetryOptions.AddMaxAttemptsFlag(flags)
+	cli.GlobalOutputOptions.AddPagerFlag(flags)
+	cli.GlobalOutputOptions.AddTimeZoneFlag(flags)
+	cli.GlobalProgressOptions.AddNoProgressFlag(flags)
+
 	cobra.OnInitialize(initLog)
 
 	return rootCmd
 }
 
+// applyDefaults replaces the built-in flag defaults of the command
+// which will run for the given arguments with those of the plug-in
+// config and OCM_ADDONS_* environment variables. Defaults are not
+// applied to the 'config' commands so that a malformed config file
+// can still be viewed and repaired.
+func applyDefaults(rootCmd *cobra.Command, args []string) error {
+	cmd, _, err := rootCmd.Find(args)
+	if err != nil {
+		// Unknown commands are reported when executing.
+		return nil
+	}
+
+	if isConfigCommand(cmd) {
+		return nil
+	}
+
+	cfg, err := cli.LoadPluginConfig()
+	if err != nil {
+		return err
+	}
+
+	defaults, err := cfg.CommandDefaults(cli.CommandPath(cmd), os.Getenv)
+	if err != nil {
+		return err
+	}
+
+	return cli.ApplyDefaults(cmd, defaults)
+}
+
+// isConfigCommand returns true if cmd is the 'config' command or one
+// of its subcommands.
+func isConfigCommand(cmd *cobra.Command) bool {
+	for ; cmd != nil && cmd.HasParent(); cmd = cmd.Parent() {
+		if cmd.Parent() == cmd.Root() {
+			return cmd.Name() == "config"
+		}
+	}
+
+	return false
+}
+
 func initLog() {
 	log.SetHandler(apexcli.Default)
 	log.SetLevel(cli.LogLevel(verbosity))
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Err      error
+		Expected int
+	}{
+		"success": {},
+		"cancelled": {
+			Err: fmt.Errorf("sending notification: %w", context.Canceled),
+		},
+		"partial failure": {
+			Err:      fmt.Errorf("1 of 2 cluster(s) failed: %w", cli.ErrPartialFailure),
+			Expected: _exitPartialFailure,
+		},
+		"failure": {
+			Err:      errors.New("failure"),
+			Expected: 1,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.Expected, exitCode(tc.Err))
+		})
+	}
+}
+
+func TestApplyDefaultsMalformedConfig(t *testing.T) { //nolint:paralleltest
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("defaults: ["), 0o600))
+
+	t.Setenv(cli.EnvPluginConfig, path)
+
+	assert.Error(t, applyDefaults(generateRootCmd(), []string{"list"}))
+	assert.NoError(t, applyDefaults(generateRootCmd(), []string{"config"}))
+	assert.NoError(t, applyDefaults(generateRootCmd(), []string{"config", "set", "pager", "less"}))
+}
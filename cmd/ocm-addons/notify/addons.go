@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+)
+
+// hasRequiredAddon returns true if the cluster has one of the add-ons
+// listed by the notification installed or the notification does not
+// list any add-ons. Installations are retrieved only if needed and any
+// failure to retrieve them is returned.
+func hasRequiredAddon(ctx context.Context, c *ocm.Cluster, cfg notification.Config) (bool, error) {
+	if len(cfg.Addons) == 0 {
+		return true, nil
+	}
+
+	versions, err := c.InstalledAddonVersions(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := cfg.InstalledAddon(versions)
+
+	return ok, nil
+}
+
+func addonMismatchReason(addons []string) string {
+	return fmt.Sprintf("none of the add-ons [%s] are installed", strings.Join(addons, ", "))
+}
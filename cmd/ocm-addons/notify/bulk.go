@@ -0,0 +1,559 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/spf13/cobra"
+)
+
+// readClusterIdentifiers reads one cluster ID, external ID or name per
+// line ignoring blank lines and lines starting with '#'. The path '-'
+// reads from the supplied stdin.
+func readClusterIdentifiers(path string, stdin io.Reader) ([]string, error) {
+	r := stdin
+
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening clusters file: %w", err)
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	var (
+		result []string
+		seen   = make(map[string]struct{})
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if _, ok := seen[line]; ok {
+			continue
+		}
+
+		seen[line] = struct{}{}
+
+		result = append(result, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading clusters file: %w", err)
+	}
+
+	return result, nil
+}
+
+type deliveryStatus string
+
+const (
+	deliveryStatusSent    deliveryStatus = "sent"
+	deliveryStatusSkipped deliveryStatus = "skipped"
+	deliveryStatusFailed  deliveryStatus = "failed"
+)
+
+// delivery tracks a notification for a single target cluster. The
+// cluster is nil if the target could not be resolved.
+type delivery struct {
+	cluster  *ocm.Cluster
+	rendered notification.Config
+	result   deliveryResult
+}
+
+type deliveryResult struct {
+	ClusterID  string         `json:"cluster_id,omitempty"`
+	ExternalID string         `json:"external_id,omitempty"`
+	Name       string         `json:"name,omitempty"`
+	LogID      string         `json:"log_id,omitempty"`
+	Status     deliveryStatus `json:"status"`
+	Reason     string         `json:"reason,omitempty"`
+}
+
+func (r *deliveryResult) ProvideRowData() map[string]interface{} {
+	return map[string]interface{}{
+		"Cluster ID":  r.ClusterID,
+		"External ID": r.ExternalID,
+		"Name":        r.Name,
+		"Log ID":      r.LogID,
+		"Status":      string(r.Status),
+		"Reason":      r.Reason,
+	}
+}
+
+func newDelivery(c *ocm.Cluster) *delivery {
+	return &delivery{
+		cluster: c,
+		result: deliveryResult{
+			ClusterID:  c.ID(),
+			ExternalID: c.ExternalID(),
+			Name:       c.Name(),
+		},
+	}
+}
+
+func unresolvedDelivery(ident string) *delivery {
+	return &delivery{
+		result: deliveryResult{
+			Name:   ident,
+			Status: deliveryStatusFailed,
+			Reason: "no matching cluster found",
+		},
+	}
+}
+
+func (d *delivery) pending() bool { return d.result.Status == "" }
+
+func (d *delivery) fail(err error) {
+	d.result.Status = deliveryStatusFailed
+	d.result.Reason = err.Error()
+}
+
+func (d *delivery) skip(reason string) {
+	d.result.Status = deliveryStatusSkipped
+	d.result.Reason = reason
+}
+
+// matchDeliveries creates a delivery for every cluster and an
+// unresolved delivery for each identifier no cluster matched. Clusters
+// which were resolved from the identifiers but are not among clusters,
+// because they were excluded by selectors, are skipped rather than
+// reported as unresolved.
+func matchDeliveries(clusters, resolved []*ocm.Cluster, idents []string) []*delivery {
+	result := make([]*delivery, 0, len(clusters)+len(idents))
+	matched := make(map[string]struct{})
+	selected := make(map[string]struct{}, len(clusters))
+
+	match := func(c *ocm.Cluster) {
+		matched[c.ID()] = struct{}{}
+		matched[c.ExternalID()] = struct{}{}
+		matched[c.Name()] = struct{}{}
+	}
+
+	for _, c := range clusters {
+		result = append(result, newDelivery(c))
+		selected[c.ID()] = struct{}{}
+
+		match(c)
+	}
+
+	for _, c := range resolved {
+		if _, ok := selected[c.ID()]; ok {
+			continue
+		}
+
+		d := newDelivery(c)
+		d.skip("excluded by selectors")
+
+		result = append(result, d)
+		selected[c.ID()] = struct{}{}
+
+		match(c)
+	}
+
+	for _, ident := range idents {
+		if _, ok := matched[ident]; !ok {
+			result = append(result, unresolvedDelivery(ident))
+		}
+	}
+
+	return result
+}
+
+// forEachPending applies fn to every pending delivery using at most
+// 'concurrency' goroutines. Deliveries which are still pending once
+// the context is cancelled are skipped.
+func forEachPending(ctx context.Context, deliveries []*delivery, concurrency int, fn func(*delivery)) {
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+
+	for _, d := range deliveries {
+		if !d.pending() {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			d.skip(err.Error())
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			d.skip(ctx.Err().Error())
+
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+
+		go func(d *delivery) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			fn(d)
+		}(d)
+	}
+
+	wg.Wait()
+}
+
+// collectClusters returns a copy of every cluster requested by pager.
+func collectClusters(ctx context.Context, pager *ocm.ClusterPager, opts ...ocm.ForEachOption) ([]*ocm.Cluster, error) {
+	var clusters []*ocm.Cluster
+
+	err := pager.ForEach(ctx, func(c *ocm.Cluster) error {
+		cpy := *c
+		clusters = append(clusters, &cpy)
+
+		return nil
+	}, opts...)
+
+	return clusters, err
+}
+
+// filterByInstallation returns the clusters on which an add-on
+// installation matching the filter exists preserving their order.
+// Installations are retrieved using at most 'concurrency' goroutines
+// and the first error encountered is returned.
+func filterByInstallation(
+	ctx context.Context,
+	clusters []*ocm.Cluster,
+	filter ocm.AddonInstallationFilter,
+	concurrency int,
+) ([]*ocm.Cluster, error) {
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, concurrency)
+		matches = make([]bool, len(clusters))
+		errs    = make([]error, len(clusters))
+	)
+
+	for i, c := range clusters {
+		sem <- struct{}{}
+
+		wg.Add(1)
+
+		go func(i int, c *ocm.Cluster) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			matches[i], errs[i] = c.HasAddonInstallation(ctx, filter)
+		}(i, c)
+	}
+
+	wg.Wait()
+
+	result := make([]*ocm.Cluster, 0, len(clusters))
+
+	for i, c := range clusters {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("cluster %q: %w", c.ID(), errs[i])
+		}
+
+		if matches[i] {
+			result = append(result, c)
+		}
+	}
+
+	return result, nil
+}
+
+// deliveryError returns an error if any delivery of the report failed.
+// The error wraps cli.ErrPartialFailure unless every delivery failed.
+func deliveryError(report deliveryReport) error {
+	total := report.Sent + report.Skipped + report.Failed
+
+	switch {
+	case report.Failed == 0:
+		return nil
+	case report.Failed < total:
+		return fmt.Errorf("%d of %d cluster(s) failed: %w", report.Failed, total, cli.ErrPartialFailure)
+	default:
+		return fmt.Errorf("%d of %d cluster(s) failed: %w", report.Failed, total, errDeliveryFailed)
+	}
+}
+
+func pendingDeliveries(deliveries []*delivery) []*delivery {
+	var result []*delivery
+
+	for _, d := range deliveries {
+		if d.pending() {
+			result = append(result, d)
+		}
+	}
+
+	return result
+}
+
+// _summarySampleSize is the maximum number of clusters listed when
+// asking for confirmation.
+const _summarySampleSize = 10
+
+func writeSummary(out io.Writer, nid notification.ID, pending []*delivery) error {
+	first := pending[0]
+
+	fmt.Fprintf(out, "Notification: %s\n", nid)
+	fmt.Fprintf(out, "Service Name: %s\n", first.rendered.ServiceName)
+	fmt.Fprintf(out, "Severity: %s\n", first.rendered.Severity)
+	fmt.Fprintf(out, "Internal Only: %t\n", first.rendered.InternalOnly)
+	fmt.Fprintf(out, "Summary: %s\n", first.rendered.Summary)
+	fmt.Fprintf(out, "Description: %s\n", first.rendered.Description)
+
+	if len(pending) > 1 {
+		fmt.Fprintf(out, "(summary and description shown as rendered for cluster %q)\n", first.result.ClusterID)
+	}
+
+	fmt.Fprintf(out, "Clusters (%d):\n", len(pending))
+
+	table, err := cli.NewTable(
+		cli.WithColumns("cluster_id, external_id, name"),
+		cli.WithOutput{Out: out},
+	)
+	if err != nil {
+		return fmt.Errorf("creating table: %w", err)
+	}
+
+	sample := pending
+	if len(sample) > _summarySampleSize {
+		sample = sample[:_summarySampleSize]
+	}
+
+	for _, d := range sample {
+		if err := table.Write(&d.result); err != nil {
+			return fmt.Errorf("writing table row: %w", err)
+		}
+	}
+
+	if err := table.Flush(); err != nil {
+		return fmt.Errorf("flushing table: %w", err)
+	}
+
+	if remaining := len(pending) - len(sample); remaining > 0 {
+		fmt.Fprintf(out, "... and %d more\n", remaining)
+	}
+
+	return nil
+}
+
+// writeDryRun previews the entry which would be posted for the
+// delivery and marks the delivery as skipped.
+func writeDryRun(out io.Writer, d *delivery) error {
+	entry, err := ocm.NewLogEntry(d.cluster, d.rendered.LogEntryOptions()...)
+	if err != nil {
+		d.fail(fmt.Errorf("creating log entry: %w", err))
+
+		return nil
+	}
+
+	entryJSON, err := entry.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "=== Cluster %s (%s) ===\n", d.result.ClusterID, d.result.Name)
+
+	if err := notification.WritePreview(out, entryJSON, d.rendered); err != nil {
+		return err
+	}
+
+	d.skip("dry run")
+
+	return nil
+}
+
+// deliveryStreams separates the delivery report from all other output
+// so that machine-readable reports can be parsed from standard output.
+type deliveryStreams struct {
+	in io.Reader
+	// out receives only the delivery report.
+	out io.Writer
+	// msgs receives previews, summaries, prompts and progress messages.
+	msgs   io.Writer
+	format string
+}
+
+// newDeliveryStreams returns the streams of cmd for the given report
+// format. Messages are written to standard error for JSON reports and
+// to standard output otherwise.
+func newDeliveryStreams(cmd *cobra.Command, format string) deliveryStreams {
+	streams := deliveryStreams{
+		in:     cmd.InOrStdin(),
+		out:    cmd.OutOrStdout(),
+		msgs:   cmd.OutOrStdout(),
+		format: format,
+	}
+
+	if format == reportFormatJSON {
+		streams.msgs = cmd.ErrOrStderr()
+	}
+
+	return streams
+}
+
+func (s deliveryStreams) writeReport(report deliveryReport, noColor bool) error {
+	return writeReport(s.out, s.format, report, noColor)
+}
+
+// writeEmptyReport writes an empty JSON report when no clusters were
+// matched so that standard output remains valid JSON. Table reports
+// are omitted.
+func (s deliveryStreams) writeEmptyReport() error {
+	if s.format != reportFormatJSON {
+		return nil
+	}
+
+	return s.writeReport(deliveryReport{Results: []deliveryResult{}}, true)
+}
+
+// sendPending writes a preview of each pending delivery for a dry run
+// or otherwise sends each pending delivery using send after the user
+// confirmed the summary. The delivery report is written last.
+func sendPending(
+	ctx context.Context,
+	streams deliveryStreams,
+	opts *options,
+	nid notification.ID,
+	deliveries []*delivery,
+	send func(*delivery),
+) (deliveryReport, error) {
+	if opts.DryRun {
+		for _, d := range pendingDeliveries(deliveries) {
+			if err := writeDryRun(streams.msgs, d); err != nil {
+				return deliveryReport{}, err
+			}
+		}
+	}
+
+	if pending := pendingDeliveries(deliveries); len(pending) > 0 {
+		if err := writeSummary(streams.msgs, nid, pending); err != nil {
+			return deliveryReport{}, err
+		}
+
+		if opts.Yes || cli.PromptYesOrNo(streams.msgs, streams.in, "Please confirm before sending this notification") {
+			fmt.Fprintln(streams.msgs, "sending notification...")
+
+			forEachPending(ctx, pending, opts.Concurrency, send)
+		} else {
+			fmt.Fprintln(streams.msgs, "notification cancelled")
+
+			for _, d := range pending {
+				d.skip("cancelled by user")
+			}
+		}
+	}
+
+	report := newDeliveryReport(deliveries)
+
+	return report, streams.writeReport(report, opts.NoColor)
+}
+
+const (
+	reportFormatTable = "table"
+	reportFormatJSON  = "json"
+)
+
+var errUnknownReportFormat = errors.New("unknown report format")
+
+func parseReportFormat(format string) (string, error) {
+	switch f := strings.ToLower(strings.TrimSpace(format)); f {
+	case reportFormatTable, reportFormatJSON:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%q: %w", format, errUnknownReportFormat)
+	}
+}
+
+type deliveryReport struct {
+	Sent    int              `json:"sent"`
+	Skipped int              `json:"skipped"`
+	Failed  int              `json:"failed"`
+	Results []deliveryResult `json:"results"`
+}
+
+func newDeliveryReport(deliveries []*delivery) deliveryReport {
+	report := deliveryReport{
+		Results: make([]deliveryResult, 0, len(deliveries)),
+	}
+
+	for _, d := range deliveries {
+		switch d.result.Status {
+		case deliveryStatusSent:
+			report.Sent++
+		case deliveryStatusSkipped:
+			report.Skipped++
+		case deliveryStatusFailed:
+			report.Failed++
+		}
+
+		report.Results = append(report.Results, d.result)
+	}
+
+	return report
+}
+
+func writeReport(out io.Writer, format string, report deliveryReport, noColor bool) error {
+	if format == reportFormatJSON {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(report); err != nil {
+			return fmt.Errorf("encoding report: %w", err)
+		}
+
+		return nil
+	}
+
+	table, err := cli.NewTable(
+		cli.WithColumns("cluster_id, external_id, name, status, log_id, reason"),
+		cli.WithNoColor(noColor),
+		cli.WithOutput{Out: out},
+	)
+	if err != nil {
+		return fmt.Errorf("creating table: %w", err)
+	}
+
+	for i := range report.Results {
+		if err := table.Write(&report.Results[i]); err != nil {
+			return fmt.Errorf("writing table row: %w", err)
+		}
+	}
+
+	if err := table.Flush(); err != nil {
+		return fmt.Errorf("flushing table: %w", err)
+	}
+
+	fmt.Fprintf(out, "%d sent, %d skipped, %d failed\n", report.Sent, report.Skipped, report.Failed)
+
+	return nil
+}
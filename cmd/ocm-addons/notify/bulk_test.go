@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadClusterIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	in := strings.NewReader(strings.Join([]string{
+		"# production clusters",
+		"cluster-a",
+		"",
+		"  cluster-b  ",
+		"cluster-a",
+	}, "\n"))
+
+	idents, err := readClusterIdentifiers("-", in)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster-a", "cluster-b"}, idents)
+
+	_, err = readClusterIdentifiers("does-not-exist.txt", nil)
+	assert.Error(t, err)
+}
+
+func TestMatchDeliveries(t *testing.T) {
+	t.Parallel()
+
+	clusters := []*ocm.Cluster{
+		testCluster(t, "id-a", "ext-a", "cluster-a"),
+		testCluster(t, "id-b", "ext-b", "cluster-b"),
+	}
+
+	deliveries := matchDeliveries(clusters, nil, []string{"cluster-a", "ext-b", "missing"})
+	require.Len(t, deliveries, 3)
+
+	assert.True(t, deliveries[0].pending())
+	assert.True(t, deliveries[1].pending())
+	assert.Equal(t, deliveryResult{
+		Name:   "missing",
+		Status: deliveryStatusFailed,
+		Reason: "no matching cluster found",
+	}, deliveries[2].result)
+	assert.Len(t, pendingDeliveries(deliveries), 2)
+}
+
+func TestMatchDeliveriesExcludedBySelectors(t *testing.T) {
+	t.Parallel()
+
+	selected := testCluster(t, "id-a", "ext-a", "cluster-a")
+	excluded := testCluster(t, "id-b", "ext-b", "cluster-b")
+
+	deliveries := matchDeliveries(
+		[]*ocm.Cluster{selected},
+		[]*ocm.Cluster{selected, excluded},
+		[]string{"cluster-a", "ext-b", "missing"},
+	)
+	require.Len(t, deliveries, 3)
+
+	assert.True(t, deliveries[0].pending())
+	assert.Equal(t, deliveryResult{
+		ClusterID:  "id-b",
+		ExternalID: "ext-b",
+		Name:       "cluster-b",
+		Status:     deliveryStatusSkipped,
+		Reason:     "excluded by selectors",
+	}, deliveries[1].result)
+	assert.Equal(t, deliveryStatusFailed, deliveries[2].result.Status)
+	assert.Len(t, pendingDeliveries(deliveries), 1)
+}
+
+func TestForEachPending(t *testing.T) {
+	t.Parallel()
+
+	deliveries := make([]*delivery, 0, 10)
+
+	for i := 0; i < 10; i++ {
+		deliveries = append(deliveries, &delivery{})
+	}
+
+	deliveries = append(deliveries, unresolvedDelivery("missing"))
+
+	var calls, active, maxActive int32
+
+	forEachPending(context.Background(), deliveries, 3, func(d *delivery) {
+		atomic.AddInt32(&calls, 1)
+
+		cur := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+
+		for {
+			prev := atomic.LoadInt32(&maxActive)
+			if cur <= prev || atomic.CompareAndSwapInt32(&maxActive, prev, cur) {
+				break
+			}
+		}
+
+		d.result.Status = deliveryStatusSent
+	})
+
+	assert.Equal(t, int32(10), calls)
+	assert.LessOrEqual(t, maxActive, int32(3))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cancelled := []*delivery{{}}
+
+	forEachPending(ctx, cancelled, 1, func(*delivery) {
+		t.Fatal("should not be called once the context is cancelled")
+	})
+
+	assert.Equal(t, deliveryStatusSkipped, cancelled[0].result.Status)
+}
+
+func TestWriteReport(t *testing.T) {
+	t.Parallel()
+
+	deliveries := []*delivery{
+		{result: deliveryResult{ClusterID: "id-a", Status: deliveryStatusSent}},
+		{result: deliveryResult{ClusterID: "id-b"}},
+		unresolvedDelivery("missing"),
+	}
+
+	deliveries[1].skip("cancelled by user")
+
+	report := newDeliveryReport(deliveries)
+	assert.Equal(t, 1, report.Sent)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 1, report.Failed)
+
+	var out bytes.Buffer
+
+	require.NoError(t, writeReport(&out, reportFormatJSON, report, true))
+
+	var decoded deliveryReport
+
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, report, decoded)
+
+	out.Reset()
+
+	require.NoError(t, writeReport(&out, reportFormatTable, report, true))
+	assert.Contains(t, out.String(), "1 sent, 1 skipped, 1 failed")
+	assert.Contains(t, out.String(), "no matching cluster found")
+}
+
+func TestSendPendingJSONReport(t *testing.T) {
+	t.Parallel()
+
+	deliveries := []*delivery{
+		{
+			cluster: testCluster(t, "id-a", "external-a", "cluster-a"),
+			rendered: notification.Config{
+				ServiceName: "SREManualAction",
+				Severity:    "Info",
+				Summary:     "summary",
+				Description: "description",
+			},
+			result: deliveryResult{ClusterID: "id-a", Name: "cluster-a"},
+		},
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+
+	opts := &options{DryRun: true, Yes: true}
+	nid := notification.ID{Team: "team", Product: "product", ID: "id"}
+
+	report, err := sendPending(context.Background(), newDeliveryStreams(cmd, reportFormatJSON), opts, nid, deliveries,
+		func(*delivery) {
+			t.Fatal("should not send during a dry run")
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Skipped)
+
+	var decoded deliveryReport
+
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &decoded))
+	assert.Equal(t, report, decoded)
+
+	assert.Contains(t, stderr.String(), "=== Cluster id-a (cluster-a) ===")
+}
+
+func TestDeliveryError(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, deliveryError(deliveryReport{Sent: 2, Skipped: 1}))
+
+	err := deliveryError(deliveryReport{Sent: 1, Failed: 1})
+	assert.ErrorIs(t, err, cli.ErrPartialFailure)
+	assert.EqualError(t, err, "1 of 2 cluster(s) failed: partial failure")
+
+	err = deliveryError(deliveryReport{Failed: 2})
+	assert.ErrorIs(t, err, errDeliveryFailed)
+	assert.NotErrorIs(t, err, cli.ErrPartialFailure)
+}
+
+func TestWriteSummarySample(t *testing.T) {
+	t.Parallel()
+
+	pending := make([]*delivery, _summarySampleSize+3)
+
+	for i := range pending {
+		pending[i] = &delivery{
+			result: deliveryResult{ClusterID: fmt.Sprintf("id-%02d", i)},
+		}
+	}
+
+	var out bytes.Buffer
+
+	require.NoError(t, writeSummary(&out, notification.ID{Team: "team", Product: "product", ID: "id"}, pending))
+
+	assert.Contains(t, out.String(), fmt.Sprintf("Clusters (%d):", len(pending)))
+	assert.Contains(t, out.String(), "id-09")
+	assert.NotContains(t, out.String(), "id-10")
+	assert.Contains(t, out.String(), "... and 3 more")
+}
+
+func TestParseReportFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := parseReportFormat(" JSON ")
+	require.NoError(t, err)
+	assert.Equal(t, reportFormatJSON, format)
+
+	_, err = parseReportFormat("yaml")
+	assert.ErrorIs(t, err, errUnknownReportFormat)
+}
+
+func testCluster(t *testing.T, id, externalID, name string) *ocm.Cluster {
+	t.Helper()
+
+	cluster, err := cmv1.NewCluster().
+		ID(id).
+		ExternalID(externalID).
+		Name(name).
+		Build()
+	require.NoError(t, err)
+
+	c := ocm.NewCluster(cluster)
+
+	return &c
+}
@@ -5,24 +5,218 @@
 package notify
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/notify/history"
 	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/notify/list"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/notify/preview"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/notify/retract"
+	"github.com/mt-sre/ocm-addons/cmd/ocm-addons/notify/validate"
 	"github.com/mt-sre/ocm-addons/internal/cli"
 	"github.com/mt-sre/ocm-addons/internal/notification"
 	"github.com/mt-sre/ocm-addons/internal/ocm"
 
 	"github.com/apex/log"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func Cmd() *cobra.Command {
-	return generateCommand(run)
+	opts := options{
+		Concurrency: _defaultConcurrency,
+		Output:      reportFormatTable,
+	}
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	cli.ClusterSelectionOptions
+	cli.CommonOptions
+	Addon              string
+	AddonState         string
+	AddonVersion       string
+	AllowAddonMismatch bool
+	ClustersFile       string
+	Concurrency        int
+	DedupeWindow       time.Duration
+	DryRun             bool
+	Force              bool
+	NotificationsDirs  []string
+	Org                string
+	Output             string
+	Product            string
+	Set                []string
+	Yes                bool
+}
+
+// hasSelectors returns true if target clusters are selected by
+// add-on installation, organization, product or an OCM search
+// expression rather than by a cluster search argument.
+func (o *options) hasSelectors() bool {
+	return o.Addon != "" || o.AddonVersion != "" || o.AddonState != "" || o.Org != "" || o.Product != "" ||
+		o.ClusterSearch != ""
+}
+
+// addonFilter returns the add-on installation filter for the
+// selector flags or nil if no add-on was selected.
+func (o *options) addonFilter() *ocm.AddonInstallationFilter {
+	if o.Addon == "" {
+		return nil
+	}
+
+	return &ocm.AddonInstallationFilter{
+		AddonID:   o.Addon,
+		VersionID: o.AddonVersion,
+		State:     o.AddonState,
+	}
+}
+
+func (o *options) AddAddonFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Addon,
+		"addon",
+		o.Addon,
+		"targets clusters on which the add-on with the given ID is installed",
+	)
+}
+
+func (o *options) AddAllowAddonMismatchFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.AllowAddonMismatch,
+		"allow-addon-mismatch",
+		o.AllowAddonMismatch,
+		"sends with a warning to clusters on which none of the notification's 'addons' are installed",
+	)
+}
+
+func (o *options) AddAddonVersionFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.AddonVersion,
+		"addon-version",
+		o.AddonVersion,
+		"targets only clusters on which the version of the '--addon' installation matches",
+	)
+}
+
+func (o *options) AddAddonStateFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.AddonState,
+		"addon-state",
+		o.AddonState,
+		"targets only clusters on which the state of the '--addon' installation matches (e.g. 'ready', 'failed')",
+	)
+}
+
+func (o *options) AddOrgFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Org,
+		"org",
+		o.Org,
+		"targets only clusters owned by the organization with the given ID",
+	)
+}
+
+func (o *options) AddProductFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Product,
+		"product",
+		o.Product,
+		"targets only clusters of the given product (e.g. 'osd', 'rosa')",
+	)
+}
+
+func (o *options) AddClustersFileFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.ClustersFile,
+		"clusters-file",
+		o.ClustersFile,
+		"reads target clusters from a file with one cluster ID, external ID or name per line; '-' reads from stdin",
+	)
+}
+
+func (o *options) AddConcurrencyFlag(flags *pflag.FlagSet) {
+	flags.IntVar(
+		&o.Concurrency,
+		"concurrency",
+		o.Concurrency,
+		"maximum number of clusters to notify in parallel",
+	)
+}
+
+func (o *options) AddOutputFlag(flags *pflag.FlagSet) {
+	flags.StringVarP(
+		&o.Output,
+		"output",
+		"o",
+		o.Output,
+		"format of the delivery report (table, json)",
+	)
 }
 
-const _numArgs = 2
+func (o *options) AddYesFlag(flags *pflag.FlagSet) {
+	flags.BoolVarP(
+		&o.Yes,
+		"yes",
+		"y",
+		o.Yes,
+		"sends without asking for confirmation",
+	)
+}
+
+func (o *options) AddDedupeWindowFlag(flags *pflag.FlagSet) {
+	flags.DurationVar(
+		&o.DedupeWindow,
+		"dedupe-window",
+		o.DedupeWindow,
+		"refuses to send if the cluster received the same notification within this period; "+
+			"defaults to the notification's 'dedupeWindow' and '0s' disables the check",
+	)
+}
+
+func (o *options) AddForceFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Force,
+		"force",
+		o.Force,
+		"sends even if the cluster recently received the same notification",
+	)
+}
+
+func (o *options) AddDryRunFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.DryRun,
+		"dry-run",
+		o.DryRun,
+		"renders the service log entry and customer email for each cluster without sending",
+	)
+}
+
+func (o *options) AddNotificationsDirFlag(flags *pflag.FlagSet) {
+	flags.StringArrayVar(
+		&o.NotificationsDirs,
+		"notifications-dir",
+		o.NotificationsDirs,
+		"loads additional notifications from a catalog directory; may be repeated with later directories taking precedence",
+	)
+}
+
+func (o *options) AddSetFlag(flags *pflag.FlagSet) {
+	flags.StringArrayVar(
+		&o.Set,
+		"set",
+		o.Set,
+		"sets a notification template variable as 'key=value'; may be repeated",
+	)
+}
+
+const (
+	_numArgs            = 2
+	_defaultConcurrency = 4
+)
 
 const _example = `
 # Sending a notification with the following details:
@@ -31,119 +225,360 @@ const _example = `
 # Notification Config ID: "example-notification"
 # Cluster                 "example-cluster"
   ocm addons notify example-cluster example-team/example-product/example-notification
+
+# Sending a notification defined in an external catalog directory
+  ocm addons notify example-cluster example-team/example-product/example-notification --notifications-dir ./notifications
+
+# Sending a notification to every cluster listed in a file without confirmation
+  ocm addons notify --clusters-file clusters.txt example-team/example-product/example-notification --yes
+
+# Showing the service log entry and customer email without sending
+  ocm addons notify example-cluster example-team/example-product/example-notification --dry-run
+
+# Sending a notification even though it was sent to the cluster within the last hour
+  ocm addons notify example-cluster example-team/example-product/example-notification --force
+
+# Sending a notification which declares a 'threshold' template variable
+  ocm addons notify example-cluster example-team/example-product/example-notification --set threshold=80
+
+# Sending a notification to every ROSA cluster with a failed installation of 'example-addon'
+  ocm addons notify example-team/example-product/example-notification --addon example-addon --addon-state failed --product rosa
+
+# Sending a notification to every cluster of an organization running version '1.2.3' of 'example-addon'
+  ocm addons notify example-team/example-product/example-notification --addon example-addon --addon-version 1.2.3 --org example-org-id
+
+# Sending a notification to every GCP cluster in 'us-east1'
+  ocm addons notify example-team/example-product/example-notification \
+    --cluster-search "cloud_provider.id = 'gcp' and region.id = 'us-east1'"
 `
 
-func generateCommand(run func(*cobra.Command, []string) error) *cobra.Command {
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
 	cmd := &cobra.Command{
 		Aliases: []string{"notification", "notifications"},
 		Use:     "notify [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH] NOTIFICATION_ID",
 		Example: _example,
 		Short:   "post customer notifications",
 		Long:    "Post add-on related notification to cluster service_logs for customer to view.",
-		Args:    cobra.MinimumNArgs(_numArgs),
-		RunE:    run,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.ClustersFile != "" || opts.hasSelectors() {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+
+			return cobra.MinimumNArgs(_numArgs)(cmd, args)
+		},
+		RunE: run,
+		// Catalogs are loaded for every subcommand so that external
+		// notifications are also available to them.
+		PersistentPreRunE: loadCatalogs(opts),
 	}
 
+	opts.AddNotificationsDirFlag(cmd.PersistentFlags())
+
+	flags := cmd.Flags()
+
+	opts.AddSetFlag(flags)
+	opts.AddClustersFileFlag(flags)
+	opts.AddClusterSearchFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddAddonFlag(flags)
+	opts.AddAddonVersionFlag(flags)
+	opts.AddAddonStateFlag(flags)
+	opts.AddOrgFlag(flags)
+	opts.AddProductFlag(flags)
+	opts.AddConcurrencyFlag(flags)
+	opts.AddDedupeWindowFlag(flags)
+	opts.AddDryRunFlag(flags)
+	opts.AddForceFlag(flags)
+	opts.AddAllowAddonMismatchFlag(flags)
+	opts.AddOutputFlag(flags)
+	opts.AddYesFlag(flags)
+	opts.AddNoColorFlag(flags)
+
+	cmd.AddCommand(history.Cmd())
 	cmd.AddCommand(list.Cmd())
+	cmd.AddCommand(preview.Cmd())
+	cmd.AddCommand(retract.Cmd())
+	cmd.AddCommand(validate.Cmd())
 
 	return cmd
 }
 
-func run(cmd *cobra.Command, args []string) error {
-	var (
-		ctx = cmd.Context()
-		in  = cmd.InOrStdin()
-		out = cmd.OutOrStdout()
-	)
+var (
+	errAddonRequired       = errors.New("'--addon-version' and '--addon-state' require '--addon'")
+	errInvalidConcurrency  = errors.New("concurrency must be at least 1")
+	errInvalidDedupeWindow = errors.New("dedupe window must not be negative")
+	errStdinRequiresYes    = errors.New("reading clusters from stdin requires '--yes'")
+	errDeliveryFailed      = errors.New("notification could not be delivered to any cluster")
+)
 
-	sess, err := cli.NewSession()
-	if err != nil {
-		return fmt.Errorf("starting session: %w", err)
-	}
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
 
-	defer sess.End()
+		if opts.Concurrency < 1 {
+			return errInvalidConcurrency
+		}
 
-	search := args[0]
-	notificationID := args[1]
+		if opts.Addon == "" && (opts.AddonVersion != "" || opts.AddonState != "") {
+			return errAddonRequired
+		}
 
-	trace := sess.Logger().
-		WithFields(log.Fields{
-			"command":        "notify",
-			"search":         search,
-			"notificationID": notificationID,
-		}).
-		Trace("running command")
-	defer trace.Stop(nil)
+		if opts.ClustersFile == "-" && !opts.Yes && !opts.DryRun {
+			return errStdinRequiresYes
+		}
 
-	cfg, err := getNotificationConfig(notificationID)
-	if err != nil {
-		return fmt.Errorf("getting notification %q: %w", notificationID, err)
-	}
+		format, err := parseReportFormat(opts.Output)
+		if err != nil {
+			return err
+		}
 
-	entryOpts := []ocm.LogEntryOption{
-		ocm.LogEntryDescription(cfg.Description),
-		ocm.LogEntryServiceName(cfg.ServiceName),
-		ocm.LogEntrySeverity(cfg.Severity),
-		ocm.LogEntrySummary(cfg.Summary),
-	}
+		streams := newDeliveryStreams(cmd, format)
 
-	if cfg.InternalOnly {
-		entryOpts = append(entryOpts, ocm.LogEntryInternalOnly)
-	}
+		vars, err := notification.ParseVariables(opts.Set)
+		if err != nil {
+			return err
+		}
 
-	pager, err := ocm.RetrieveClusters(sess.Conn(), trace)
-	if err != nil {
-		return fmt.Errorf("retrieving clusters: %w", err)
-	}
+		var (
+			search string
+			idents []string
+			rawID  = args[len(args)-1]
+		)
+
+		if opts.ClustersFile != "" {
+			if idents, err = readClusterIdentifiers(opts.ClustersFile, streams.in); err != nil {
+				return err
+			}
+		} else if !opts.hasSelectors() {
+			search, rawID = args[0], args[1]
+		}
 
-	matchingClusters := pager.SearchByNameOrID(search)
+		nid, err := notification.ParseID(rawID)
+		if err != nil {
+			return fmt.Errorf("parsing notification %q: %w", rawID, err)
+		}
 
-	return matchingClusters.ForEach(ctx, func(c *ocm.Cluster) error {
-		fmt.Fprintf(out, "Cluster External ID: %s\n", c.ExternalID())
-		fmt.Fprintf(out, "Description: %s\n", cfg.Description)
-		fmt.Fprintf(out, "Service Name: %s\n", cfg.ServiceName)
-		fmt.Fprintf(out, "Severity: %s\n", cfg.Severity)
-		fmt.Fprintf(out, "Summary: %s\n", cfg.Summary)
-		fmt.Fprintf(out, "Internal Only: %s\n", fmt.Sprint(cfg.InternalOnly))
+		cfg, err := notification.Lookup(nid)
+		if err != nil {
+			return fmt.Errorf("getting notification %q: %w", nid, err)
+		}
 
-		if !cli.PromptYesOrNo(out, in, "Please confirm before sending this notification") {
-			fmt.Fprintln(out, "notification cancelled")
+		if _, err := cfg.ResolveVariables(vars); err != nil {
+			return fmt.Errorf("resolving variables for notification %q: %w", nid, err)
+		}
 
-			return nil
+		window := cfg.DedupeWindow
+		if cmd.Flags().Changed("dedupe-window") {
+			window = opts.DedupeWindow
 		}
 
-		fmt.Fprintln(out, "sending notification...")
+		if window < 0 {
+			return errInvalidDedupeWindow
+		}
+
+		sess, err := cli.NewSession()
+		if err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
 
-		if err := c.PostLog(ctx, entryOpts...); err != nil {
-			return fmt.Errorf("failed to send notification: %w", err)
+		defer sess.End()
+
+		trace := sess.Logger().
+			WithFields(log.Fields{
+				"command":        "notify",
+				"search":         search,
+				"clustersFile":   opts.ClustersFile,
+				"addon":          opts.Addon,
+				"org":            opts.Org,
+				"product":        opts.Product,
+				"clusterSearch":  opts.ClusterSearch,
+				"notificationID": nid.String(),
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		pager, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), opts.ClusterOption())
+		if err != nil {
+			return fmt.Errorf("retrieving clusters: %w", err)
 		}
 
-		fmt.Fprintln(out, "notification sent successfully")
+		matchingClusters := pager.SearchByNameOrID(search)
+		if opts.ClustersFile != "" {
+			if len(idents) == 0 {
+				fmt.Fprintln(streams.msgs, "no clusters listed in clusters file")
 
-		return nil
-	})
+				return streams.writeEmptyReport()
+			}
+
+			matchingClusters = pager.FindByIdentifiers(idents...)
+		}
+
+		selectedClusters := opts.Select(matchingClusters.
+			FindByOrganization(opts.Org).
+			FindByProduct(opts.Product))
+
+		progress := cli.NewProgress(cmd.ErrOrStderr(), cli.WithMachineReadable(format == reportFormatJSON))
+		defer progress.Stop()
+
+		clusters, err := collectClusters(ctx, selectedClusters, progress.ForEachOption())
+		if err != nil {
+			return fmt.Errorf("retrieving matching clusters: %w", err)
+		}
+
+		progress.Stop()
+
+		if filter := opts.addonFilter(); filter != nil {
+			if clusters, err = filterByInstallation(ctx, clusters, *filter, opts.Concurrency); err != nil {
+				return fmt.Errorf("filtering clusters by add-on installation: %w", err)
+			}
+		}
+
+		var resolved []*ocm.Cluster
+
+		if opts.ClustersFile != "" && opts.hasSelectors() {
+			if resolved, err = collectClusters(ctx, matchingClusters); err != nil {
+				return fmt.Errorf("resolving listed clusters: %w", err)
+			}
+		}
+
+		deliveries := matchDeliveries(clusters, resolved, idents)
+		if len(deliveries) == 0 {
+			fmt.Fprintln(streams.msgs, "no matching clusters found")
+
+			return streams.writeEmptyReport()
+		}
+
+		forEachPending(ctx, deliveries, opts.Concurrency, func(d *delivery) {
+			installed, err := hasRequiredAddon(ctx, d.cluster, cfg)
+			if err != nil {
+				d.fail(fmt.Errorf("checking add-on installations: %w", err))
+
+				return
+			}
+
+			if !installed {
+				if !opts.AllowAddonMismatch {
+					d.skip(addonMismatchReason(cfg.Addons))
+
+					return
+				}
+
+				trace.
+					WithFields(log.Fields{
+						"cluster": d.cluster.ID(),
+						"addons":  cfg.Addons,
+					}).
+					Warn("none of the notification's add-ons are installed on the cluster")
+			}
+
+			cc, err := clusterContext(ctx, d.cluster, nid, &cfg)
+			if err != nil {
+				d.fail(fmt.Errorf("retrieving cluster context: %w", err))
+
+				return
+			}
+
+			if d.rendered, err = cfg.Render(cc, vars); err != nil {
+				d.fail(fmt.Errorf("rendering notification: %w", err))
+
+				return
+			}
+
+			if opts.Force || window == 0 {
+				return
+			}
+
+			dup, found, err := findDuplicate(ctx, d.cluster, d.rendered, window)
+			if err != nil {
+				d.fail(fmt.Errorf("checking for duplicate notifications: %w", err))
+
+				return
+			}
+
+			if found {
+				trace.
+					WithFields(log.Fields{
+						"cluster": d.cluster.ID(),
+						"logID":   dup.Entry.ID(),
+					}).
+					Warn("notification was already sent within the dedupe window")
+
+				d.skip(duplicateReason(dup))
+			}
+		})
+
+		report, err := sendPending(ctx, streams, opts, nid, deliveries, func(d *delivery) {
+			entry, err := d.cluster.PostLog(ctx, d.rendered.LogEntryOptions()...)
+			if err != nil {
+				d.fail(err)
+
+				return
+			}
+
+			d.result.Status = deliveryStatusSent
+			d.result.LogID = entry.Entry.ID()
+		})
+		if err != nil {
+			return err
+		}
+
+		return deliveryError(report)
+	}
 }
 
-var (
-	errInvalidNotificationID = errors.New("invalid notificstion ID")
-	errNotificationNotFound  = errors.New("notification not found")
-)
+func loadCatalogs(opts *options) func(*cobra.Command, []string) error {
+	return func(_ *cobra.Command, _ []string) error {
+		dirs := notification.CatalogDirs(opts.NotificationsDirs...)
+
+		conflicts, err := notification.LoadCatalogs(dirs...)
+		if err != nil {
+			return fmt.Errorf("loading notification catalogs: %w", err)
+		}
 
-func getNotificationConfig(rawID string) (notification.Config, error) {
-	const numParts = 3
+		for _, c := range conflicts {
+			log.
+				WithFields(log.Fields{
+					"notification": fmt.Sprintf("%s/%s/%s", c.Team, c.Product, c.ID),
+					"source":       c.Source,
+					"overridden":   c.Overridden,
+				}).
+				Warn("notification is defined by multiple catalogs")
+		}
 
-	parsed := strings.SplitN(rawID, "/", numParts)
+		return nil
+	}
+}
 
-	if len(parsed) < numParts {
-		return notification.Config{}, errInvalidNotificationID
+// clusterContext collects the values of built-in template variables for
+// the given cluster. Add-on installations are only requested if the
+// notification references the installed add-on version in which case
+// the add-on is identified by the notification's product.
+func clusterContext(ctx context.Context, c *ocm.Cluster, nid notification.ID, cfg *notification.Config) (notification.ClusterContext, error) {
+	cc := notification.ClusterContext{
+		ClusterExternalID: c.ExternalID(),
+		ClusterID:         c.ID(),
+		ClusterName:       c.Name(),
+		ConsoleURL:        c.ConsoleURL(),
 	}
 
-	team, product, id := parsed[0], parsed[1], parsed[2]
+	if !cfg.UsesVariable(notification.VarAddonVersion) {
+		return cc, nil
+	}
+
+	versions, err := c.InstalledAddonVersions(ctx)
+	if err != nil {
+		return cc, err
+	}
+
+	if version, ok := versions[nid.Product]; ok {
+		cc.AddonVersion = version
+
+		return cc, nil
+	}
 
-	cfg, ok := notification.GetNotification(team, product, id)
-	if !ok {
-		return notification.Config{}, errNotificationNotFound
+	if addonID, ok := cfg.InstalledAddon(versions); ok {
+		cc.AddonVersion = versions[addonID]
 	}
 
-	return cfg, nil
+	return cc, nil
 }
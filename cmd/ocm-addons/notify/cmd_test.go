@@ -31,6 +31,87 @@ func TestCmdArguments(t *testing.T) {
 			args:    []string{"fake-team", "fake-notification-id"},
 			reports: []interface{}{"should execute successfully"},
 		},
+		"clusters file with notification ID": {
+			command: mockCommand(),
+			args:    []string{"--clusters-file", "clusters.txt", "fake-notification-id"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"clusters file without notification ID": {
+			command:     mockCommand(),
+			args:        []string{"--clusters-file", "clusters.txt"},
+			expectation: "accepts 1 arg(s), received 0",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+		"clusters file with cluster argument": {
+			command:     mockCommand(),
+			args:        []string{"--clusters-file", "clusters.txt", "fake-cluster", "fake-notification-id"},
+			expectation: "accepts 1 arg(s), received 2",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+		"bulk delivery flags": {
+			command: mockCommand(),
+			args: []string{
+				"fake-team", "fake-notification-id",
+				"--yes", "--concurrency", "8", "--output", "json", "--no-color",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"concurrency flag with no arguments": {
+			command:     mockCommand(),
+			args:        []string{"fake-team", "fake-notification-id", "--concurrency"},
+			expectation: "flag needs an argument: --concurrency",
+			reports:     []interface{}{"should fail expecting an argument"},
+		},
+		"dry run flag": {
+			command: mockCommand(),
+			args:    []string{"fake-team", "fake-notification-id", "--dry-run"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"dedupe flags": {
+			command: mockCommand(),
+			args:    []string{"fake-team", "fake-notification-id", "--dedupe-window", "30m", "--force"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"invalid dedupe window": {
+			command:     mockCommand(),
+			args:        []string{"fake-team", "fake-notification-id", "--dedupe-window", "soon"},
+			expectation: `invalid argument "soon" for "--dedupe-window" flag`,
+			reports:     []interface{}{"should fail parsing the duration"},
+		},
+		"addon selectors with notification ID": {
+			command: mockCommand(),
+			args: []string{
+				"fake-notification-id",
+				"--addon", "fake-addon", "--addon-version", "1.0.0", "--addon-state", "ready",
+				"--org", "fake-org", "--product", "rosa",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"addon selector with cluster argument": {
+			command:     mockCommand(),
+			args:        []string{"--addon", "fake-addon", "fake-cluster", "fake-notification-id"},
+			expectation: "accepts 1 arg(s), received 2",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+		"product selector without notification ID": {
+			command:     mockCommand(),
+			args:        []string{"--product", "osd"},
+			expectation: "accepts 1 arg(s), received 0",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+		"allow addon mismatch flag": {
+			command: mockCommand(),
+			args:    []string{"fake-team", "fake-notification-id", "--allow-addon-mismatch"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"with template variables": {
+			command: mockCommand(),
+			args: []string{
+				"fake-team", "fake-notification-id",
+				"--set", "threshold=80", "--set", "volume=data",
+			},
+			reports: []interface{}{"should execute successfully"},
+		},
 	}
 
 	for name, test := range testcases {
@@ -51,5 +132,5 @@ func TestCmdArguments(t *testing.T) {
 }
 
 func mockCommand() *cobra.Command {
-	return generateCommand(testutil.NoOp)
+	return generateCommand(new(options), testutil.NoOp)
 }
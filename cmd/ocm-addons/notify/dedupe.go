@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+)
+
+// findDuplicate returns a service log entry of the cluster sent within
+// the window which has the same summary and service name as the rendered
+// config. The second return value is false if there is no such entry.
+func findDuplicate(ctx context.Context, c *ocm.Cluster, rendered notification.Config, window time.Duration) (ocm.LogEntry, bool, error) {
+	opts := ocm.NewGetLogsOptions(
+		ocm.GetLogsAfter(time.Now().UTC().Add(-window)),
+		ocm.GetLogsWithServiceName(rendered.ServiceName),
+		ocm.GetLogsWithSummary(rendered.Summary),
+		ocm.GetLogsByTime(ocm.OrderDesc),
+	)
+
+	entries, err := c.GetLogs(ctx, opts)
+	if err != nil {
+		return ocm.LogEntry{}, false, fmt.Errorf("retrieving service logs: %w", err)
+	}
+
+	for _, entry := range entries {
+		if isDuplicate(entry, rendered) {
+			return entry, true, nil
+		}
+	}
+
+	return ocm.LogEntry{}, false, nil
+}
+
+func isDuplicate(entry ocm.LogEntry, rendered notification.Config) bool {
+	return entry.Entry.Summary() == rendered.Summary &&
+		entry.Entry.ServiceName() == rendered.ServiceName
+}
+
+func duplicateReason(entry ocm.LogEntry) string {
+	reason := fmt.Sprintf("already sent at %s", entry.Entry.Timestamp().Format(time.RFC3339))
+
+	if user := entry.Entry.Username(); user != "" {
+		reason += " by " + user
+	}
+
+	return reason + "; use --force to send again"
+}
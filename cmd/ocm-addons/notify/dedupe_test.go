@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDuplicate(t *testing.T) {
+	t.Parallel()
+
+	rendered := notification.Config{
+		ServiceName: "SREManualAction",
+		Summary:     "StorageNearlyFull",
+	}
+
+	for name, tc := range map[string]struct {
+		ServiceName string
+		Summary     string
+		Expected    bool
+	}{
+		"same summary and service name": {
+			ServiceName: "SREManualAction",
+			Summary:     "StorageNearlyFull",
+			Expected:    true,
+		},
+		"different summary": {
+			ServiceName: "SREManualAction",
+			Summary:     "StorageFull",
+		},
+		"different service name": {
+			ServiceName: "OtherService",
+			Summary:     "StorageNearlyFull",
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			entry := testLogEntry(t, tc.ServiceName, tc.Summary, "")
+
+			assert.Equal(t, tc.Expected, isDuplicate(entry, rendered))
+		})
+	}
+}
+
+func TestDuplicateReason(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"already sent at 2022-01-01T10:00:00Z by jdoe; use --force to send again",
+		duplicateReason(testLogEntry(t, "SREManualAction", "StorageNearlyFull", "jdoe")),
+	)
+	assert.Equal(t,
+		"already sent at 2022-01-01T10:00:00Z; use --force to send again",
+		duplicateReason(testLogEntry(t, "SREManualAction", "StorageNearlyFull", "")),
+	)
+}
+
+func testLogEntry(t *testing.T, serviceName, summary, username string) ocm.LogEntry {
+	t.Helper()
+
+	entry, err := slv1.NewLogEntry().
+		ServiceName(serviceName).
+		Summary(summary).
+		Username(username).
+		Timestamp(time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)).
+		Build()
+	require.NoError(t, err)
+
+	return ocm.LogEntry{Entry: entry}
+}
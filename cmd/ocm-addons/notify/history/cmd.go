@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"fmt"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/apex/log"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	opts.DefaultColumns("timestamp, notification, severity, summary, username, internal_only")
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	cli.ClusterSelectionOptions
+	cli.CommonOptions
+	cli.PagingOptions
+}
+
+const _example = `
+# List the catalog notifications a cluster has already received
+  ocm addons notify history example-cluster
+
+# Include the ID of each service log entry
+  ocm addons notify history example-cluster --columns "timestamp, notification, username, id"
+`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME|CLUSTER_NAME_SEARCH]",
+		Short: "list notifications previously sent to a cluster",
+		Long: "Lists the service logs of a cluster created by the services used in the notification " +
+			"catalogs and maps each entry back to the notification it was sent from. Entries which " +
+			"match no notification are shown with an empty notification.",
+		Example: _example,
+		Args:    opts.ClusterArgs(cobra.ExactArgs(1)),
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddClusterSearchFlag(flags)
+	opts.AddColumnsFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddLimitFlag(flags)
+	opts.AddNoColorFlag(flags)
+	opts.AddNoHeadersFlag(flags)
+	opts.AddPageSizeFlag(flags)
+
+	return cmd
+}
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		paging, err := opts.Paging()
+		if err != nil {
+			return err
+		}
+
+		sess, err := cli.NewSession()
+		if err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
+
+		defer sess.End()
+
+		search, _ := opts.SplitClusterArg(args)
+
+		trace := sess.Logger().
+			WithFields(log.Fields{
+				"command":       "notify history",
+				"search":        search,
+				"clusterSearch": opts.ClusterSearch,
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		pager, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), opts.ClusterOption())
+		if err != nil {
+			return fmt.Errorf("retrieving clusters: %w", err)
+		}
+
+		table, err := cli.NewTable(
+			cli.WithColumns(opts.Columns),
+			cli.WithNoHeaders(opts.NoHeaders),
+			cli.WithNoColor(opts.NoColor),
+			cli.WithPager(sess.Pager()),
+			cli.WithOutput{Out: cmd.OutOrStdout()},
+		)
+		if err != nil {
+			return fmt.Errorf("creating table: %w", err)
+		}
+
+		defer table.Flush()
+
+		logOpts := ocm.NewGetLogsOptions(
+			ocm.GetLogsWithServiceName(notification.ServiceNames()...),
+			ocm.GetLogsByTime(ocm.OrderDesc),
+			ocm.GetLogsPaged(paging),
+		)
+
+		return opts.Select(pager.SearchByNameOrID(search)).ForEach(ctx, func(c *ocm.Cluster) error {
+			entries, err := c.GetLogs(ctx, logOpts)
+			if err != nil {
+				return fmt.Errorf("retrieving service logs for cluster %q: %w", c.ID(), err)
+			}
+
+			for i := range entries {
+				if err := table.Write(&entries[i], cli.WithAdditionalFields(
+					map[string]interface{}{
+						"notification": notificationOf(entries[i]),
+					},
+				)); err != nil {
+					return fmt.Errorf("writing table row: %w", err)
+				}
+			}
+
+			return nil
+		})
+	}
+}
+
+func notificationOf(entry ocm.LogEntry) string {
+	id, ok := notification.Match(
+		entry.Entry.ServiceName(),
+		entry.Entry.Summary(),
+		entry.Entry.Description(),
+	)
+	if !ok {
+		return ""
+	}
+
+	return id.String()
+}
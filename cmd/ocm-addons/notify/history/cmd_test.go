@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command:     mockCommand(),
+			expectation: "accepts 1 arg(s), received 0",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+		"one argument": {
+			command: mockCommand(),
+			args:    []string{"fake-cluster"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"table flags": {
+			command: mockCommand(),
+			args:    []string{"fake-cluster", "--no-headers", "--no-color", "--columns", "timestamp"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"two arguments": {
+			command:     mockCommand(),
+			args:        []string{"fake-cluster", "other"},
+			expectation: "accepts 1 arg(s), received 2",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func TestNotificationOf(t *testing.T) {
+	t.Parallel()
+
+	entry, err := slv1.NewLogEntry().
+		ServiceName("SREManualAction").
+		Summary("CephOSDCriticallyFull").
+		Description("Utilization of back-end storage device (OSD) has crossed 80%. " +
+			"Immediately free up some space or expand the storage cluster or contact support").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "mtsre/ocs-converged/ceph-osd-critically-full", notificationOf(ocm.LogEntry{Entry: entry}))
+
+	entry, err = slv1.NewLogEntry().
+		ServiceName("SREManualAction").
+		Summary("AdHocSummary").
+		Build()
+	require.NoError(t, err)
+
+	assert.Empty(t, notificationOf(ocm.LogEntry{Entry: entry}))
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
@@ -18,7 +18,7 @@ import (
 func Cmd() *cobra.Command {
 	var opts options
 
-	opts.DefaultColumns("team, product, id, severity, summary")
+	opts.DefaultColumns("team, product, id, severity, summary, source")
 
 	return generateCommand(&opts, run(&opts))
 }
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preview
+
+import (
+	"fmt"
+
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/apex/log"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	Set []string
+}
+
+func (o *options) AddSetFlag(flags *pflag.FlagSet) {
+	flags.StringArrayVar(
+		&o.Set,
+		"set",
+		o.Set,
+		"sets a notification template variable as 'key=value'; may be repeated",
+	)
+}
+
+const _example = `
+# Preview the service log entry and customer email for a notification
+  ocm addons notify preview example-team/example-product/example-notification
+
+# Preview a notification which declares a 'threshold' template variable
+  ocm addons notify preview example-team/example-product/example-notification --set threshold=80
+`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview TEAM/PRODUCT/ID",
+		Short: "preview a notification",
+		Long: "Renders the service log entry and an approximation of the customer email for a " +
+			"notification without sending it. Built-in cluster variables are shown as placeholders.",
+		Example: _example,
+		Args:    cobra.ExactArgs(1),
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddSetFlag(flags)
+
+	return cmd
+}
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		vars, err := notification.ParseVariables(opts.Set)
+		if err != nil {
+			return err
+		}
+
+		nid, err := notification.ParseID(args[0])
+		if err != nil {
+			return fmt.Errorf("parsing notification %q: %w", args[0], err)
+		}
+
+		trace := log.
+			WithFields(log.Fields{
+				"command":        "notify preview",
+				"notificationID": nid.String(),
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		cfg, err := notification.Lookup(nid)
+		if err != nil {
+			return fmt.Errorf("getting notification %q: %w", nid, err)
+		}
+
+		cc := notification.PlaceholderClusterContext()
+
+		rendered, err := cfg.Render(cc, vars)
+		if err != nil {
+			return fmt.Errorf("rendering notification %q: %w", nid, err)
+		}
+
+		cluster, err := cmv1.NewCluster().
+			ID(cc.ClusterID).
+			ExternalID(cc.ClusterExternalID).
+			Name(cc.ClusterName).
+			Build()
+		if err != nil {
+			return fmt.Errorf("building placeholder cluster: %w", err)
+		}
+
+		placeholder := ocm.NewCluster(cluster)
+
+		entry, err := ocm.NewLogEntry(&placeholder, rendered.LogEntryOptions()...)
+		if err != nil {
+			return fmt.Errorf("creating log entry: %w", err)
+		}
+
+		entryJSON, err := entry.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		return notification.WritePreview(out, entryJSON, rendered)
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preview
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command:     mockCommand(),
+			expectation: "accepts 1 arg(s), received 0",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+		"one argument": {
+			command: mockCommand(),
+			args:    []string{"fake-team/fake-product/fake-id"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"with template variables": {
+			command: mockCommand(),
+			args:    []string{"fake-team/fake-product/fake-id", "--set", "threshold=80"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"two arguments": {
+			command:     mockCommand(),
+			args:        []string{"fake-team/fake-product/fake-id", "other"},
+			expectation: "accepts 1 arg(s), received 2",
+			reports:     []interface{}{"should fail expecting 1 arg"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	cmd := Cmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"mtsre/ocs-converged/ceph-osd-critically-full"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, out.String(), `"cluster_id": "CLUSTER_ID"`)
+	assert.Contains(t, out.String(), `"summary": "CephOSDCriticallyFull"`)
+	assert.Contains(t, out.String(), "Subject: CephOSDCriticallyFull")
+	assert.Contains(t, out.String(), "<h2>CephOSDCriticallyFull</h2>")
+
+	cmd = Cmd()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"mtsre/ocs-converged/does-not-exist"})
+
+	assert.Error(t, cmd.Execute())
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
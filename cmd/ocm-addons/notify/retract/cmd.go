@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retract
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/notification"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+
+	"github.com/apex/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	cli.ClusterSelectionOptions
+	Yes bool
+}
+
+func (o *options) AddYesFlag(flags *pflag.FlagSet) {
+	flags.BoolVarP(
+		&o.Yes,
+		"yes",
+		"y",
+		o.Yes,
+		"retracts without asking for confirmation",
+	)
+}
+
+const _numArgs = 2
+
+const _example = `
+# Retract a notification sent to a cluster using the log ID reported by 'ocm addons notify'
+  ocm addons notify retract example-cluster 2cRvB4mRkpc1Kd7dFMbR3S0tb7a
+
+# Retract a notification sent to the only cluster matching an OCM search expression
+  ocm addons notify retract --cluster-search "subscription.id = 'example-subscription'" 2cRvB4mRkpc1Kd7dFMbR3S0tb7a
+`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retract [CLUSTER_ID|EXTERNAL_ID|CLUSTER_NAME] LOG_ID",
+		Short: "retract a notification sent to a cluster",
+		Long: "Deletes a service log entry previously sent to a cluster after confirmation. Only entries " +
+			"whose service name is used by a notification catalog may be retracted.",
+		Example: _example,
+		Args:    opts.ClusterArgs(cobra.ExactArgs(_numArgs)),
+		RunE:    run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddClusterSearchFlag(flags)
+	opts.AddIncludeUnmanagedFlag(flags)
+	opts.AddYesFlag(flags)
+
+	return cmd
+}
+
+var (
+	errClusterNotFound    = errors.New("no matching cluster found")
+	errAmbiguousCluster   = errors.New("more than one cluster matches")
+	errUnknownServiceName = errors.New("service name is not used by any notification catalog")
+)
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var (
+			ctx          = cmd.Context()
+			in           = cmd.InOrStdin()
+			out          = cmd.OutOrStdout()
+			search, rest = opts.SplitClusterArg(args)
+			logID        = rest[0]
+		)
+
+		sess, err := cli.NewSession()
+		if err != nil {
+			return fmt.Errorf("starting session: %w", err)
+		}
+
+		defer sess.End()
+
+		trace := sess.Logger().
+			WithFields(log.Fields{
+				"command":       "notify retract",
+				"search":        search,
+				"logID":         logID,
+				"clusterSearch": opts.ClusterSearch,
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		pager, err := ocm.RetrieveClusters(sess.Conn(), trace, sess.RetryOption(), opts.ClusterOption())
+		if err != nil {
+			return fmt.Errorf("retrieving clusters: %w", err)
+		}
+
+		selector := opts.ClusterSearch
+
+		if search != "" {
+			pager, selector = pager.FindByIdentifiers(search), search
+		}
+
+		var clusters []*ocm.Cluster
+
+		if err := opts.Select(pager).ForEach(ctx, func(c *ocm.Cluster) error {
+			cpy := *c
+			clusters = append(clusters, &cpy)
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("retrieving matching clusters: %w", err)
+		}
+
+		switch len(clusters) {
+		case 0:
+			return fmt.Errorf("%q: %w", selector, errClusterNotFound)
+		case 1:
+		default:
+			return fmt.Errorf("%q: %w", selector, errAmbiguousCluster)
+		}
+
+		cluster := clusters[0]
+
+		entry, err := cluster.GetLog(ctx, logID)
+		if err != nil {
+			return fmt.Errorf("retrieving log entry %q: %w", logID, err)
+		}
+
+		if err := checkServiceName(entry, notification.ServiceNames()); err != nil {
+			return err
+		}
+
+		writeEntry(out, cluster, entry)
+
+		if !opts.Yes && !cli.PromptYesOrNo(out, in, "Please confirm before retracting this notification") {
+			fmt.Fprintln(out, "retraction cancelled")
+
+			return nil
+		}
+
+		if err := cluster.DeleteLog(ctx, logID); err != nil {
+			return fmt.Errorf("retracting log entry %q: %w", logID, err)
+		}
+
+		fmt.Fprintf(out, "retracted log entry %q from cluster %q\n", logID, cluster.ID())
+
+		return nil
+	}
+}
+
+// checkServiceName returns an error unless the entry was created
+// with one of the given catalog service names.
+func checkServiceName(entry ocm.LogEntry, serviceNames []string) error {
+	name := entry.Entry.ServiceName()
+
+	for _, known := range serviceNames {
+		if name == known {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q: %w", name, errUnknownServiceName)
+}
+
+func writeEntry(out io.Writer, cluster *ocm.Cluster, entry ocm.LogEntry) {
+	fmt.Fprintf(out, "Cluster: %s (%s)\n", cluster.ID(), cluster.Name())
+	fmt.Fprintf(out, "Log ID: %s\n", entry.Entry.ID())
+	fmt.Fprintf(out, "Timestamp: %s\n", entry.Entry.Timestamp())
+	fmt.Fprintf(out, "Sent By: %s\n", entry.Entry.Username())
+	fmt.Fprintf(out, "Service Name: %s\n", entry.Entry.ServiceName())
+	fmt.Fprintf(out, "Severity: %s\n", entry.Entry.Severity())
+	fmt.Fprintf(out, "Summary: %s\n", entry.Entry.Summary())
+	fmt.Fprintf(out, "Description: %s\n", entry.Entry.Description())
+}
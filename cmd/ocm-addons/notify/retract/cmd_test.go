@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retract
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command:     mockCommand(),
+			expectation: "accepts 2 arg(s), received 0",
+			reports:     []interface{}{"should fail expecting 2 args"},
+		},
+		"cluster only": {
+			command:     mockCommand(),
+			args:        []string{"fake-cluster"},
+			expectation: "accepts 2 arg(s), received 1",
+			reports:     []interface{}{"should fail expecting 2 args"},
+		},
+		"cluster and log ID": {
+			command: mockCommand(),
+			args:    []string{"fake-cluster", "fake-log-id", "--yes"},
+			reports: []interface{}{"should execute successfully"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func TestCheckServiceName(t *testing.T) {
+	t.Parallel()
+
+	entry, err := slv1.NewLogEntry().ServiceName("SREManualAction").Build()
+	require.NoError(t, err)
+
+	assert.NoError(t, checkServiceName(ocm.LogEntry{Entry: entry}, []string{"OtherService", "SREManualAction"}))
+	assert.ErrorIs(t, checkServiceName(ocm.LogEntry{Entry: entry}, []string{"OtherService"}), errUnknownServiceName)
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
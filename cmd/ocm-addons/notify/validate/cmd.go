@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mt-sre/ocm-addons/internal/notification"
+
+	"github.com/apex/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	return generateCommand(&opts, run(&opts))
+}
+
+type options struct {
+	Strict bool
+}
+
+func (o *options) AddStrictFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Strict,
+		"strict",
+		o.Strict,
+		"treats warnings as errors",
+	)
+}
+
+const _example = `
+# Validate a catalog directory containing one subdirectory per team
+  ocm addons notify validate ./notifications
+
+# Validate a single catalog file
+  ocm addons notify validate ./notifications/example-team/example-product.yaml
+
+# Validate every external catalog which would be loaded by 'ocm addons notify'
+  ocm addons notify validate
+`
+
+func generateCommand(opts *options, run func(*cobra.Command, []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [PATH...]",
+		Short: "validate notification catalogs",
+		Long: "Validates notification catalog directories or files using the same rules applied when " +
+			"notifications are loaded and reports style problems. If no paths are given the external " +
+			"catalogs which would be loaded by 'ocm addons notify' are validated.",
+		Example: _example,
+		Args:    cobra.ArbitraryArgs,
+		// Catalogs are validated rather than loaded so that invalid
+		// catalogs can be reported instead of aborting the command.
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+		RunE:              run,
+	}
+
+	flags := cmd.Flags()
+
+	opts.AddStrictFlag(flags)
+
+	return cmd
+}
+
+var (
+	errNoCatalogs       = errors.New("no catalogs to validate")
+	errValidationFailed = errors.New("validation failed")
+)
+
+func run(opts *options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		paths := args
+		if len(paths) == 0 {
+			// '--notifications-dir' is inherited from the parent command when present.
+			dirs, _ := cmd.Flags().GetStringArray("notifications-dir")
+
+			paths = notification.CatalogDirs(dirs...)
+		}
+
+		if len(paths) == 0 {
+			return errNoCatalogs
+		}
+
+		trace := log.
+			WithFields(log.Fields{
+				"command": "notify validate",
+				"paths":   paths,
+			}).
+			Trace("running command")
+		defer trace.Stop(nil)
+
+		linter := notification.NewLinter()
+
+		for _, path := range paths {
+			if err := linter.LintPath(path); err != nil {
+				return fmt.Errorf("validating %q: %w", path, err)
+			}
+		}
+
+		findings := linter.Findings()
+
+		for _, f := range findings {
+			fmt.Fprintln(out, f)
+		}
+
+		numErrors, numWarnings := findings.Errors(), findings.Warnings()
+
+		fmt.Fprintf(out, "%d error(s), %d warning(s)\n", numErrors, numWarnings)
+
+		if numErrors > 0 || (opts.Strict && numWarnings > 0) {
+			return errValidationFailed
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCmdArguments(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		command     *cobra.Command
+		args        []string
+		expectation string
+		reports     []interface{}
+	}{
+		"no arguments": {
+			command: mockCommand(),
+			reports: []interface{}{"should execute successfully"},
+		},
+		"one or more arguments": {
+			command: mockCommand(),
+			args:    []string{"fake-path", "other-fake-path"},
+			reports: []interface{}{"should execute successfully"},
+		},
+		"strict flag": {
+			command: mockCommand(),
+			args:    []string{"fake-path", "--strict"},
+			reports: []interface{}{"should execute successfully"},
+		},
+	}
+
+	for name, test := range testcases {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testutil.NewCommandAssertion(
+				t,
+				testutil.CommandAssertionCommand(test.command),
+				testutil.CommandAssertionArgs(test.args...),
+				testutil.CommandAssertionExpectation(test.expectation),
+				testutil.CommandAssertionReports(test.reports...),
+			)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Data        []string
+		Args        []string
+		Expected    []string
+		ShouldError bool
+	}{
+		"valid": {
+			Data: []string{
+				"---",
+				"test-notification:",
+				"  summary: TestNotificationSummary",
+				"  description: Test",
+				"  severity: Error",
+			},
+			Expected: []string{"0 error(s), 0 warning(s)"},
+		},
+		"invalid": {
+			Data: []string{
+				"---",
+				"test-notification:",
+				"  summary: TestNotificationSummary",
+				"  description: Test.",
+				"  severity: Critical",
+			},
+			Expected: []string{
+				`test-product.yaml:4:16: error: notification "test-notification": field "description" must not end with punctuation`,
+				`test-product.yaml:5:13: error: notification "test-notification": field "severity" must be one of`,
+				"2 error(s), 0 warning(s)",
+			},
+			ShouldError: true,
+		},
+		"warnings with strict": {
+			Data: []string{
+				"---",
+				"test-notification:",
+				"  summary: TestNotificationSummary",
+				"  description: Test",
+				"  severity: Error",
+				"  sevrity: Error",
+			},
+			Args: []string{"--strict"},
+			Expected: []string{
+				`test-product.yaml:6:3: warning: unknown field "sevrity"`,
+				"0 error(s), 1 warning(s)",
+			},
+			ShouldError: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			teamDir := filepath.Join(root, "test-team")
+
+			require.NoError(t, os.MkdirAll(teamDir, 0o755))
+			require.NoError(t, os.WriteFile(
+				filepath.Join(teamDir, "test-product.yaml"),
+				[]byte(strings.Join(tc.Data, "\n")),
+				0o600,
+			))
+
+			var out bytes.Buffer
+
+			cmd := Cmd()
+			cmd.SetOut(&out)
+			cmd.SetArgs(append([]string{root}, tc.Args...))
+
+			err := cmd.Execute()
+			if tc.ShouldError {
+				assert.ErrorIs(t, err, errValidationFailed)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			for _, expected := range tc.Expected {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
+
+func mockCommand() *cobra.Command {
+	return generateCommand(new(options), testutil.NoOp)
+}
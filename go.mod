@@ -14,6 +14,7 @@ require (
 	github.com/go-playground/validator/v10 v10.26.0
 	github.com/google/go-github/v43 v43.0.0
 	github.com/magefile/mage v1.15.0
+	github.com/mattn/go-isatty v0.0.16
 	github.com/onsi/ginkgo/v2 v2.23.4
 	github.com/onsi/gomega v1.37.0
 	github.com/openshift-online/ocm-cli v1.0.5
@@ -77,7 +78,6 @@ require (
 	github.com/leodido/go-urn v1.4.0 // indirect
 	github.com/lithammer/fuzzysearch v1.1.8 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
-	github.com/mattn/go-isatty v0.0.16 // indirect
 	github.com/mattn/go-runewidth v0.0.16 // indirect
 	github.com/matttproud/golang_protobuf_extensions v1.0.2 // indirect
 	github.com/microcosm-cc/bluemonday v1.0.23 // indirect
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package catalog loads catalogs of YAML configs such as customer
+// notifications and limited support reasons. A catalog contains a
+// directory per team holding a YAML file per product which maps
+// config IDs to configs.
+package catalog
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Tree maps team, product and ID to a config.
+type Tree[C any] map[string]map[string]map[string]C
+
+// Load loads every team directory found at the root of the given
+// filesystem. Each config is passed to validate after it has been
+// unmarshalled and loading fails if any config is invalid.
+func Load[C any](root fs.FS, validate func(C) error) (Tree[C], error) {
+	ents, err := fs.ReadDir(root, ".")
+	if err != nil {
+		return nil, fmt.Errorf("reading root directory entries: %w", err)
+	}
+
+	result := make(Tree[C])
+
+	for _, ent := range ents {
+		if !ent.IsDir() {
+			continue
+		}
+
+		teamName := ent.Name()
+
+		subFS, err := fs.Sub(root, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("subbing team directory: %w", err)
+		}
+
+		teamConfigs, err := loadTeamDirectory(subFS, validate)
+		if err != nil {
+			return nil, fmt.Errorf("loading team config directory %q: %w", teamName, err)
+		}
+
+		result[teamName] = teamConfigs
+	}
+
+	return result, nil
+}
+
+var _extPat = regexp.MustCompile(`^.*\.ya?ml$`)
+
+func loadTeamDirectory[C any](dir fs.FS, validate func(C) error) (map[string]map[string]C, error) {
+	ents, err := fs.ReadDir(dir, ".")
+	if err != nil {
+		return nil, fmt.Errorf("reading team directory: %w", err)
+	}
+
+	result := make(map[string]map[string]C)
+
+	for _, ent := range ents {
+		name := ent.Name()
+
+		if !_extPat.MatchString(name) {
+			continue
+		}
+
+		configs, err := loadConfigFile(dir, name, validate)
+		if err != nil {
+			return nil, fmt.Errorf("loading config file %q: %w", name, err)
+		}
+
+		result[strings.TrimSuffix(name, path.Ext(name))] = configs
+	}
+
+	return result, nil
+}
+
+func loadConfigFile[C any](dir fs.FS, name string, validate func(C) error) (map[string]C, error) {
+	f, err := dir.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("opening config file: %w", err)
+	}
+
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	result := make(map[string]C)
+
+	if err := yaml.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("unmarshalling config yaml: %w", err)
+	}
+
+	for id, cfg := range result {
+		if err := validate(cfg); err != nil {
+			return nil, fmt.Errorf("validating config %q: %w", id, err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package catalog
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfig struct {
+	Summary string
+}
+
+var errMissingSummary = errors.New("missing summary")
+
+func validateTestConfig(cfg testConfig) error {
+	if cfg.Summary == "" {
+		return errMissingSummary
+	}
+
+	return nil
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	tree, err := Load(fstest.MapFS{
+		"team-a/product-a.yaml": {Data: []byte("first:\n  summary: First\nsecond:\n  summary: Second\n")},
+		"team-a/product-b.yml":  {Data: []byte("third:\n  summary: Third\n")},
+		"team-a/README.md":      {Data: []byte("# ignored")},
+		"team-b/product-a.yaml": {Data: []byte("fourth:\n  summary: Fourth\n")},
+		"top-level-file.yaml":   {Data: []byte("ignored: {}")},
+	}, validateTestConfig)
+	require.NoError(t, err)
+
+	assert.Equal(t, Tree[testConfig]{
+		"team-a": {
+			"product-a": {"first": {Summary: "First"}, "second": {Summary: "Second"}},
+			"product-b": {"third": {Summary: "Third"}},
+		},
+		"team-b": {
+			"product-a": {"fourth": {Summary: "Fourth"}},
+		},
+	}, tree)
+}
+
+func TestLoadInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := Load(fstest.MapFS{
+		"team/product.yaml": {Data: []byte("first:\n  summary: ''\n")},
+	}, validateTestConfig)
+	require.ErrorIs(t, err, errMissingSummary)
+	assert.Contains(t, err.Error(), `validating config "first"`)
+
+	_, err = Load(fstest.MapFS{
+		"team/product.yaml": {Data: []byte("- first\n")},
+	}, validateTestConfig)
+	require.Error(t, err)
+}
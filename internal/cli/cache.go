@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// EnvCacheDir names an environment variable holding the directory
+	// in which responses are cached instead of the default location.
+	EnvCacheDir = "OCM_ADDONS_CACHE_DIR"
+	// EnvCacheTTL names an environment variable holding the duration,
+	// e.g. '1h', for which cached responses remain valid.
+	EnvCacheTTL = "OCM_ADDONS_CACHE_TTL"
+)
+
+// CacheDir returns the directory in which responses are cached which is
+// read from $OCM_ADDONS_CACHE_DIR if set and otherwise is 'ocm-addons'
+// within the user's cache location.
+func CacheDir() (string, error) {
+	if dir := os.Getenv(EnvCacheDir); dir != "" {
+		return dir, nil
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("determining user cache directory: %w", err)
+	}
+
+	return filepath.Join(cacheDir, "ocm-addons"), nil
+}
+
+// CacheSettings configure the response cache in the plug-in config.
+type CacheSettings struct {
+	// TTL is the duration, e.g. '30m', for which cached responses
+	// remain valid. Caching is disabled unless TTL is positive.
+	TTL string `yaml:"ttl,omitempty"`
+}
+
+// CacheOptions control whether responses for the add-on catalog
+// are cached between invocations.
+type CacheOptions struct {
+	NoCache bool
+	Refresh bool
+	TTL     time.Duration
+}
+
+// GlobalCacheOptions are used by every session. The root command
+// binds its persistent flags to them.
+var GlobalCacheOptions CacheOptions
+
+func (o *CacheOptions) AddNoCacheFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.NoCache,
+		"no-cache",
+		o.NoCache,
+		"neither reads nor stores cached add-on data",
+	)
+}
+
+func (o *CacheOptions) AddRefreshFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.Refresh,
+		"refresh",
+		o.Refresh,
+		"ignores cached add-on data and caches the fresh responses",
+	)
+}
+
+func (o *CacheOptions) AddCacheTTLFlag(flags *pflag.FlagSet) {
+	flags.DurationVar(
+		&o.TTL,
+		"cache-ttl",
+		o.TTL,
+		"caches add-on data for the given duration, e.g. '1h'; "+
+			"defaults to $"+EnvCacheTTL+" and then the 'cache.ttl' of the plug-in config",
+	)
+}
+
+// Cache returns the response cache selected by the options, the
+// environment and the plug-in config in that order of precedence or
+// nil if caching is disabled.
+func (o CacheOptions) Cache(cfg PluginConfig, getenv func(string) string) (*ocm.Cache, error) {
+	if o.NoCache {
+		return nil, nil //nolint:nilnil
+	}
+
+	ttl, err := o.ttl(cfg, getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl <= 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	dir, err := CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return ocm.NewCache(dir, ttl, ocm.WithRefresh(o.Refresh)), nil
+}
+
+func (o CacheOptions) ttl(cfg PluginConfig, getenv func(string) string) (time.Duration, error) {
+	if o.TTL < 0 {
+		return 0, fmt.Errorf("'--cache-ttl' %s: %w; expected a non-negative duration", o.TTL, errInvalidFlagValue)
+	}
+
+	if o.TTL != 0 {
+		return o.TTL, nil
+	}
+
+	source, value := "$"+EnvCacheTTL, getenv(EnvCacheTTL)
+	if value == "" {
+		source, value = "cache.ttl", cfg.Cache.TTL
+	}
+
+	if value == "" {
+		return 0, nil
+	}
+
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q: %w", source, value, errInvalidDefaultValue)
+	}
+
+	return ttl, nil
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheOptionsTTL(t *testing.T) {
+	t.Parallel()
+
+	cfg := PluginConfig{Cache: CacheSettings{TTL: "30m"}}
+	env := func(key string) string {
+		return map[string]string{EnvCacheTTL: "2h"}[key]
+	}
+
+	ttl, err := CacheOptions{}.ttl(PluginConfig{}, noEnv)
+	require.NoError(t, err)
+	assert.Zero(t, ttl, "caching should be opt-in")
+
+	ttl, err = CacheOptions{}.ttl(cfg, noEnv)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, ttl)
+
+	ttl, err = CacheOptions{}.ttl(cfg, env)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, ttl)
+
+	ttl, err = CacheOptions{TTL: time.Minute}.ttl(cfg, env)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, ttl)
+
+	_, err = CacheOptions{}.ttl(PluginConfig{Cache: CacheSettings{TTL: "soon"}}, noEnv)
+	assert.ErrorIs(t, err, errInvalidDefaultValue)
+
+	_, err = CacheOptions{TTL: -time.Minute}.ttl(cfg, env)
+	assert.ErrorIs(t, err, errInvalidFlagValue)
+
+	cache, err := CacheOptions{NoCache: true}.Cache(cfg, env)
+	require.NoError(t, err)
+	assert.Nil(t, cache, "'--no-cache' should disable caching")
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/mt-sre/ocm-addons/internal/query"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ClusterSelectionOptions select the clusters which cluster-scoped
+// commands operate on in addition to the cluster argument.
+type ClusterSelectionOptions struct {
+	IncludeUnmanaged bool
+	ClusterSearch    string
+}
+
+func (o *ClusterSelectionOptions) AddIncludeUnmanagedFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.IncludeUnmanaged,
+		"include-unmanaged",
+		o.IncludeUnmanaged,
+		"includes clusters which are not managed by OCM, e.g. registered OCP clusters",
+	)
+}
+
+func (o *ClusterSelectionOptions) AddClusterSearchFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.ClusterSearch,
+		"cluster-search",
+		o.ClusterSearch,
+		"selects clusters using an OCM search expression, e.g. \"cloud_provider.id = 'gcp' and region.id = 'us-east1'\", "+
+			"instead of the cluster argument",
+	)
+}
+
+// ClusterOption returns the option which includes clusters not
+// managed by OCM if requested.
+func (o ClusterSelectionOptions) ClusterOption() ocm.WithIncludeUnmanaged {
+	return ocm.WithIncludeUnmanaged(o.IncludeUnmanaged)
+}
+
+// ClusterArgs wraps the positional argument validator of a command
+// whose first argument selects clusters so that the argument is
+// omitted when clusters are selected by '--cluster-search' instead.
+func (o *ClusterSelectionOptions) ClusterArgs(validate cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if o.ClusterSearch != "" {
+			args = append([]string{""}, args...)
+		}
+
+		return validate(cmd, args)
+	}
+}
+
+// SplitClusterArg returns the cluster argument and the remaining
+// arguments of a command validated by ClusterArgs. The cluster
+// argument is empty when clusters are selected by '--cluster-search'.
+func (o ClusterSelectionOptions) SplitClusterArg(args []string) (string, []string) {
+	if o.ClusterSearch != "" || len(args) == 0 {
+		return "", args
+	}
+
+	return args[0], args[1:]
+}
+
+// Select filters the clusters requested by pager for those matching
+// the '--cluster-search' expression if given.
+func (o ClusterSelectionOptions) Select(pager *ocm.ClusterPager) *ocm.ClusterPager {
+	return pager.Search(query.Raw(o.ClusterSearch))
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterSelectionOptionsClusterArgs(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Options       ClusterSelectionOptions
+		Args          []string
+		ExpectError   bool
+		ExpectedSplit []string
+	}{
+		"cluster argument": {
+			Args:          []string{"my-cluster", "my-addon"},
+			ExpectedSplit: []string{"my-cluster", "my-addon"},
+		},
+		"missing argument": {
+			Args:        []string{"my-cluster"},
+			ExpectError: true,
+		},
+		"cluster search": {
+			Options:       ClusterSelectionOptions{ClusterSearch: "region.id = 'us-east1'"},
+			Args:          []string{"my-addon"},
+			ExpectedSplit: []string{"", "my-addon"},
+		},
+		"cluster search with cluster argument": {
+			Options:     ClusterSelectionOptions{ClusterSearch: "region.id = 'us-east1'"},
+			Args:        []string{"my-cluster", "my-addon"},
+			ExpectError: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.Options.ClusterArgs(cobra.ExactArgs(2))(&cobra.Command{}, tc.Args)
+			if tc.ExpectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			pattern, rest := tc.Options.SplitClusterArg(tc.Args)
+
+			assert.Equal(t, tc.ExpectedSplit, append([]string{pattern}, rest...))
+		})
+	}
+}
+
+func TestClusterSelectionOptionsClusterOption(t *testing.T) {
+	t.Parallel()
+
+	var cfg ocm.ClusterConfig
+
+	cfg.Option(ClusterSelectionOptions{IncludeUnmanaged: true}.ClusterOption())
+
+	assert.True(t, cfg.IncludeUnmanaged)
+}
@@ -10,8 +10,8 @@ import (
 	"github.com/openshift-online/ocm-cli/pkg/config"
 )
 
-// LoadConfig loads an existing 'ocm.json' file and returns a Config object
-// if possible.
+// LoadConfig loads an existing 'ocm.json' file, or the file named by
+// $OCM_CONFIG, and returns a Config object if possible.
 func LoadConfig() (Config, error) {
 	cfg, err := config.Load()
 	if err != nil {
@@ -31,6 +31,10 @@ type Config struct {
 // IsEmpty returns true if there is no configuration file or if no
 // credentials are present with which to start a session.
 func (c Config) IsEmpty() bool {
+	if c.cfg == nil {
+		return true
+	}
+
 	armed, reason, err := c.cfg.Armed()
 	if err != nil {
 		return true
@@ -45,15 +49,27 @@ func (c Config) IsEmpty() bool {
 
 // ClientID returns the user's client id as configured.
 func (c Config) ClientID() string {
+	if c.cfg == nil {
+		return ""
+	}
+
 	return c.cfg.ClientID
 }
 
 // Pager returns the configured paging application to pipe output to.
 func (c Config) Pager() string {
+	if c.cfg == nil {
+		return ""
+	}
+
 	return c.cfg.Pager
 }
 
 // URL returns the configured OCM URL.
 func (c Config) URL() string {
+	if c.cfg == nil {
+		return ""
+	}
+
 	return c.cfg.URL
 }
@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/properties"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// EnvToken is the environment variable from which an offline
+	// or access token is read when '--token' is not given.
+	EnvToken = "OCM_TOKEN"
+	// EnvConfig is the environment variable holding the path of an
+	// alternate OCM config file to load instead of 'ocm.json'.
+	EnvConfig = "OCM_CONFIG"
+)
+
+// SessionOptions control how NewSession obtains credentials and
+// which OCM API a session connects to.
+type SessionOptions struct {
+	// Env is a comma separated list of environment profile names.
+	Env   string
+	Token string
+	URL   string
+}
+
+// Environments returns the distinct environment profile names
+// selected with '--env' in the order given.
+func (o SessionOptions) Environments() []string {
+	var (
+		result []string
+		seen   = make(map[string]struct{})
+	)
+
+	for _, name := range strings.Split(o.Env, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := seen[name]; ok {
+			continue
+		}
+
+		seen[name] = struct{}{}
+
+		result = append(result, name)
+	}
+
+	return result
+}
+
+func (o *SessionOptions) AddEnvFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Env,
+		"env",
+		o.Env,
+		"OCM environment profile from the plug-in config, or 'production', 'staging' or 'integration'; "+
+			"read-only commands such as 'list' accept a comma separated list to query several environments",
+	)
+}
+
+// GlobalSessionOptions are used by every call to NewSession. The root
+// command binds its persistent flags to them.
+var GlobalSessionOptions SessionOptions
+
+func (o *SessionOptions) AddTokenFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Token,
+		"token",
+		o.Token,
+		"offline or access token used instead of the OCM config; defaults to $"+EnvToken,
+	)
+}
+
+func (o *SessionOptions) AddURLFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.URL,
+		"url",
+		o.URL,
+		"OCM API URL or one of the aliases 'production', 'staging' or 'integration'; "+
+			"defaults to $"+properties.URLEnvKey+" and then the URL of the OCM config",
+	)
+}
+
+var _urlAliases = map[string]string{
+	"production":  sdk.DefaultURL,
+	"prod":        sdk.DefaultURL,
+	"staging":     "https://api.stage.openshift.com",
+	"stage":       "https://api.stage.openshift.com",
+	"integration": "https://api.integration.openshift.com",
+	"int":         "https://api.integration.openshift.com",
+}
+
+// ResolveURL expands OCM environment aliases such as 'staging' into
+// their API URL. Any other value is returned unchanged.
+func ResolveURL(url string) string {
+	if resolved, ok := _urlAliases[strings.ToLower(strings.TrimSpace(url))]; ok {
+		return resolved
+	}
+
+	return url
+}
+
+// credentials holds the OCM config a connection is built from along
+// with a description of where its credentials were found.
+type credentials struct {
+	cfg    *config.Config
+	source string
+}
+
+var errEmptyToken = errors.New("token is empty")
+
+// resolveCredentials selects credentials in order of precedence from
+// the '--token' flag, the token source of the environment profile, the
+// token environment variable and finally the loaded OCM config. The API
+// URL is likewise taken from the '--url' flag, the profile, the URL
+// environment variable, the OCM config or the default production URL.
+// Settings such as the pager are always taken from the loaded OCM config
+// which may be nil.
+func resolveCredentials(
+	opts SessionOptions,
+	env Environment,
+	getenv func(string) string,
+	loaded *config.Config,
+	location string,
+) (credentials, error) {
+	var (
+		cfg    = new(config.Config)
+		source string
+	)
+
+	if loaded != nil {
+		*cfg = *loaded
+	}
+
+	switch {
+	case opts.Token != "":
+		source = "'--token' flag"
+		useToken(cfg, opts.Token)
+	case env.TokenEnv != "":
+		source = "$" + env.TokenEnv
+
+		token := getenv(env.TokenEnv)
+		if token == "" {
+			return credentials{}, fmt.Errorf("%s: %w", source, errEmptyToken)
+		}
+
+		useToken(cfg, token)
+	case env.TokenFile != "":
+		source = fmt.Sprintf("token file %q", env.TokenFile)
+
+		data, err := os.ReadFile(env.TokenFile)
+		if err != nil {
+			return credentials{}, fmt.Errorf("reading %s: %w", source, err)
+		}
+
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return credentials{}, fmt.Errorf("%s: %w", source, errEmptyToken)
+		}
+
+		useToken(cfg, token)
+	case !env.hasCredentials() && getenv(EnvToken) != "":
+		source = "$" + EnvToken
+		useToken(cfg, getenv(EnvToken))
+	default:
+		source = location
+	}
+
+	switch {
+	case opts.URL != "":
+		cfg.URL = opts.URL
+	case env.URL != "":
+		cfg.URL = env.URL
+	case getenv(properties.URLEnvKey) != "":
+		cfg.URL = getenv(properties.URLEnvKey)
+	case cfg.URL == "":
+		cfg.URL = sdk.DefaultURL
+	}
+
+	cfg.URL = ResolveURL(cfg.URL)
+
+	return credentials{
+		cfg:    cfg,
+		source: source,
+	}, nil
+}
+
+// useToken replaces any credentials of the config with the given
+// token using the default SSO client.
+func useToken(cfg *config.Config, token string) {
+	cfg.AccessToken = ""
+	cfg.ClientSecret = ""
+	cfg.Password = ""
+	cfg.RefreshToken = ""
+	cfg.User = ""
+
+	cfg.ClientID = sdk.DefaultClientID
+	cfg.TokenURL = sdk.DefaultTokenURL
+
+	if typ, err := tokenType(token); err == nil && typ == "Bearer" {
+		cfg.AccessToken = token
+
+		return
+	}
+
+	cfg.RefreshToken = token
+}
+
+func tokenType(token string) (string, error) {
+	parsed, err := config.ParseToken(token)
+	if err != nil {
+		return "", err
+	}
+
+	return config.TokenType(parsed)
+}
+
+// configLocation describes where the OCM config is loaded from.
+func configLocation() string {
+	if keyring, ok := config.IsKeyringManaged(); ok {
+		return fmt.Sprintf("keyring %q", keyring)
+	}
+
+	path, err := config.Location()
+	if err != nil {
+		return "OCM config"
+	}
+
+	if os.Getenv(EnvConfig) != "" {
+		return fmt.Sprintf("config file %q ($%s)", path, EnvConfig)
+	}
+
+	return fmt.Sprintf("config file %q", path)
+}
+
+// loadOCMConfigFile loads an 'ocm.json' file from the given path.
+func loadOCMConfigFile(path string) (*config.Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading OCM config: %w", err)
+	}
+
+	var cfg config.Config
+
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing OCM config %q: %w", path, err)
+	}
+
+	return &cfg, nil
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveCredentials(t *testing.T) {
+	t.Parallel()
+
+	loaded := &config.Config{
+		RefreshToken: "config-token",
+		URL:          "https://api.stage.openshift.com",
+		Pager:        "less",
+	}
+
+	for name, tc := range map[string]struct {
+		Options          SessionOptions
+		Vars             map[string]string
+		Profile          Environment
+		Loaded           *config.Config
+		ExpectedSource   string
+		ExpectedRefresh  string
+		ExpectedAccess   string
+		ExpectedURL      string
+		ExpectedClientID string
+	}{
+		"config file": {
+			Loaded:          loaded,
+			ExpectedSource:  "config file",
+			ExpectedRefresh: "config-token",
+			ExpectedURL:     "https://api.stage.openshift.com",
+		},
+		"environment token": {
+			Vars:             map[string]string{EnvToken: "env-token"},
+			Loaded:           loaded,
+			ExpectedSource:   "$OCM_TOKEN",
+			ExpectedRefresh:  "env-token",
+			ExpectedURL:      "https://api.stage.openshift.com",
+			ExpectedClientID: sdk.DefaultClientID,
+		},
+		"flag token takes precedence": {
+			Options:          SessionOptions{Token: "flag-token", URL: "integration"},
+			Vars:             map[string]string{EnvToken: "env-token", "OCM_URL": "staging"},
+			ExpectedSource:   "'--token' flag",
+			ExpectedRefresh:  "flag-token",
+			ExpectedURL:      "https://api.integration.openshift.com",
+			ExpectedClientID: sdk.DefaultClientID,
+		},
+		"access token without config": {
+			Vars:             map[string]string{EnvToken: testToken("Bearer")},
+			ExpectedSource:   "$OCM_TOKEN",
+			ExpectedAccess:   testToken("Bearer"),
+			ExpectedURL:      sdk.DefaultURL,
+			ExpectedClientID: sdk.DefaultClientID,
+		},
+		"profile token takes precedence over environment": {
+			Vars:             map[string]string{EnvToken: "env-token", "STAGE_TOKEN": "stage-token"},
+			Profile:          Environment{URL: "staging", TokenEnv: "STAGE_TOKEN"},
+			Loaded:           loaded,
+			ExpectedSource:   "$STAGE_TOKEN",
+			ExpectedRefresh:  "stage-token",
+			ExpectedURL:      "https://api.stage.openshift.com",
+			ExpectedClientID: sdk.DefaultClientID,
+		},
+		"profile url only": {
+			Vars:            map[string]string{"OCM_URL": "https://example.com"},
+			Profile:         Environment{URL: "integration"},
+			Loaded:          loaded,
+			ExpectedSource:  "config file",
+			ExpectedRefresh: "config-token",
+			ExpectedURL:     "https://api.integration.openshift.com",
+		},
+		"url from environment": {
+			Vars:            map[string]string{"OCM_URL": "https://example.com"},
+			Loaded:          loaded,
+			ExpectedSource:  "config file",
+			ExpectedRefresh: "config-token",
+			ExpectedURL:     "https://example.com",
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(key string) string { return tc.Vars[key] }
+
+			creds, err := resolveCredentials(tc.Options, tc.Profile, getenv, tc.Loaded, "config file")
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.ExpectedSource, creds.source)
+			assert.Equal(t, tc.ExpectedRefresh, creds.cfg.RefreshToken)
+			assert.Equal(t, tc.ExpectedAccess, creds.cfg.AccessToken)
+			assert.Equal(t, tc.ExpectedURL, creds.cfg.URL)
+			assert.Equal(t, tc.ExpectedClientID, creds.cfg.ClientID)
+		})
+	}
+
+	assert.Equal(t, "config-token", loaded.RefreshToken, "loaded config must not be modified")
+}
+
+func TestResolveCredentialsTokenFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("file-token\n"), 0o600))
+
+	creds, err := resolveCredentials(SessionOptions{}, Environment{TokenFile: path}, noEnv, nil, "config file")
+	require.NoError(t, err)
+	assert.Equal(t, "file-token", creds.cfg.RefreshToken)
+	assert.Contains(t, creds.source, path)
+
+	_, err = resolveCredentials(SessionOptions{}, Environment{TokenEnv: "UNSET_TOKEN"}, noEnv, nil, "config file")
+	assert.ErrorIs(t, err, errEmptyToken)
+}
+
+func noEnv(string) string { return "" }
+
+func TestSessionOptionsEnvironments(t *testing.T) {
+	t.Parallel()
+
+	opts := SessionOptions{Env: "prod, stage,,prod"}
+
+	assert.Equal(t, []string{"prod", "stage"}, opts.Environments())
+	assert.Empty(t, SessionOptions{}.Environments())
+}
+
+func TestResolveURL(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, sdk.DefaultURL, ResolveURL("production"))
+	assert.Equal(t, "https://api.stage.openshift.com", ResolveURL(" Staging "))
+	assert.Equal(t, "https://example.com", ResolveURL("https://example.com"))
+}
+
+func testToken(typ string) string {
+	enc := base64.RawURLEncoding
+
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		enc.EncodeToString([]byte(`{"typ":"`+typ+`"}`)) + ".signature"
+}
@@ -0,0 +1,275 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CommandDefaults override the built-in default values of command flags.
+// Empty values leave the built-in default unchanged.
+type CommandDefaults struct {
+	Columns     string `yaml:"columns,omitempty"`
+	Concurrency string `yaml:"concurrency,omitempty"`
+	Order       string `yaml:"order,omitempty"`
+	OrderBy     string `yaml:"orderBy,omitempty"`
+	Output      string `yaml:"output,omitempty"`
+	Pager       string `yaml:"pager,omitempty"`
+	TimeZone    string `yaml:"timeZone,omitempty"`
+}
+
+// _defaultKeys maps the keys accepted by CommandDefaults.Set to the
+// flags whose default values they override.
+var _defaultKeys = map[string]string{
+	"columns":     "columns",
+	"concurrency": "concurrency",
+	"order":       "order",
+	"orderBy":     "order-by",
+	"output":      "output",
+	"pager":       "pager",
+	"timeZone":    "time-zone",
+}
+
+// DefaultKeys returns the keys accepted by CommandDefaults.Set.
+func DefaultKeys() []string {
+	return sortedKeys(_defaultKeys)
+}
+
+var (
+	errUnknownDefaultKey   = errors.New("unknown key")
+	errInvalidDefaultValue = errors.New("invalid value")
+)
+
+// Set assigns the value of the given key. An empty value unsets the key.
+func (d *CommandDefaults) Set(key, value string) error {
+	value = strings.TrimSpace(value)
+
+	if err := validateDefault(key, value); err != nil {
+		return err
+	}
+
+	switch key {
+	case "columns":
+		d.Columns = value
+	case "concurrency":
+		d.Concurrency = value
+	case "order":
+		d.Order = value
+	case "orderBy":
+		d.OrderBy = value
+	case "output":
+		d.Output = value
+	case "pager":
+		d.Pager = value
+	case "timeZone":
+		d.TimeZone = value
+	default:
+		return fmt.Errorf("%q: %w; expected one of [%s]", key, errUnknownDefaultKey, strings.Join(DefaultKeys(), ", "))
+	}
+
+	return nil
+}
+
+func validateDefault(key, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch key {
+	case "concurrency":
+		if n, err := strconv.Atoi(value); err != nil || n < 1 {
+			return fmt.Errorf("%s %q: %w; expected a positive integer", key, value, errInvalidDefaultValue)
+		}
+	case "timeZone":
+		if _, err := time.LoadLocation(value); err != nil {
+			return fmt.Errorf("%s %q: %w: %v", key, value, errInvalidDefaultValue, err)
+		}
+	}
+
+	return nil
+}
+
+func (d CommandDefaults) values() map[string]string {
+	return map[string]string{
+		"columns":     d.Columns,
+		"concurrency": d.Concurrency,
+		"order":       d.Order,
+		"orderBy":     d.OrderBy,
+		"output":      d.Output,
+		"pager":       d.Pager,
+		"timeZone":    d.TimeZone,
+	}
+}
+
+// merge returns the defaults with any non-empty values of
+// other taking precedence.
+func (d CommandDefaults) merge(other CommandDefaults) CommandDefaults {
+	for _, field := range []struct{ dst, src *string }{
+		{&d.Columns, &other.Columns},
+		{&d.Concurrency, &other.Concurrency},
+		{&d.Order, &other.Order},
+		{&d.OrderBy, &other.OrderBy},
+		{&d.Output, &other.Output},
+		{&d.Pager, &other.Pager},
+		{&d.TimeZone, &other.TimeZone},
+	} {
+		if *field.src != "" {
+			*field.dst = *field.src
+		}
+	}
+
+	return d
+}
+
+// EnvDefaultsPrefix prefixes environment variables overriding defaults.
+const EnvDefaultsPrefix = "OCM_ADDONS_"
+
+// EnvDefaultName returns the environment variable which overrides the
+// given key. An empty command path names the variable applying to all
+// commands, e.g. 'OCM_ADDONS_PAGER', while 'cluster events' and
+// 'columns' yield 'OCM_ADDONS_CLUSTER_EVENTS_COLUMNS'.
+func EnvDefaultName(commandPath, key string) string {
+	parts := strings.Fields(strings.ReplaceAll(commandPath, "-", " "))
+	parts = append(parts, _defaultKeys[key])
+
+	name := strings.Join(parts, "_")
+
+	return EnvDefaultsPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// CommandDefaults returns the defaults for the command with the given
+// path, e.g. 'cluster events', applying in order of increasing
+// precedence the 'defaults' and 'commands' sections of the config,
+// the OCM_ADDONS_<KEY> environment variables and finally the
+// OCM_ADDONS_<COMMAND>_<KEY> environment variables. An error is
+// returned if an environment variable holds an invalid value.
+func (c PluginConfig) CommandDefaults(commandPath string, getenv func(string) string) (CommandDefaults, error) {
+	result := c.Defaults.merge(c.Commands[commandPath])
+
+	for _, path := range []string{"", commandPath} {
+		var env CommandDefaults
+
+		for _, key := range DefaultKeys() {
+			name := EnvDefaultName(path, key)
+
+			if err := env.Set(key, getenv(name)); err != nil {
+				return CommandDefaults{}, fmt.Errorf("$%s: %w", name, err)
+			}
+		}
+
+		result = result.merge(env)
+	}
+
+	return result, nil
+}
+
+// CommandPath returns the path of a command relative to the root
+// command, e.g. 'cluster events'.
+func CommandPath(cmd *cobra.Command) string {
+	return strings.TrimSpace(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().CommandPath()))
+}
+
+// ApplyDefaults replaces the default values of the command's flags,
+// including those inherited from parent commands, with the given
+// defaults. It must be called before flags are parsed so that values
+// supplied on the command line take precedence. Keys naming flags the
+// command does not have are ignored.
+func ApplyDefaults(cmd *cobra.Command, defaults CommandDefaults) error {
+	values := defaults.values()
+
+	for _, key := range DefaultKeys() {
+		val := values[key]
+		if val == "" {
+			continue
+		}
+
+		flag := lookupFlag(cmd, _defaultKeys[key])
+		if flag == nil {
+			continue
+		}
+
+		if err := flag.Value.Set(val); err != nil {
+			return fmt.Errorf("applying default %s %q: %w", key, val, err)
+		}
+
+		flag.DefValue = flag.Value.String()
+	}
+
+	return nil
+}
+
+func lookupFlag(cmd *cobra.Command, name string) *pflag.Flag {
+	if flag := cmd.Flags().Lookup(name); flag != nil {
+		return flag
+	}
+
+	return cmd.InheritedFlags().Lookup(name)
+}
+
+// OutputOptions control presentation shared by all commands.
+type OutputOptions struct {
+	Pager    string
+	TimeZone string
+}
+
+// GlobalOutputOptions are used by every table and session. The root
+// command binds its persistent flags to them.
+var GlobalOutputOptions OutputOptions
+
+func (o *OutputOptions) AddPagerFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.Pager,
+		"pager",
+		o.Pager,
+		"pager command to pipe table output to, overriding the OCM config; 'none' disables paging",
+	)
+}
+
+func (o *OutputOptions) AddTimeZoneFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.TimeZone,
+		"time-zone",
+		o.TimeZone,
+		"IANA time zone, e.g. 'UTC', 'Local' or 'Europe/Berlin', in which timestamps are displayed; "+
+			"defaults to the time zone returned by OCM",
+	)
+}
+
+// Location returns the time zone in which timestamps are displayed
+// or nil if timestamps are displayed as returned by OCM.
+func (o OutputOptions) Location() (*time.Location, error) {
+	if o.TimeZone == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	loc, err := time.LoadLocation(o.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("loading time zone %q: %w", o.TimeZone, err)
+	}
+
+	return loc, nil
+}
+
+const pagerNone = "none"
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+
+	for k := range m {
+		result = append(result, k)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
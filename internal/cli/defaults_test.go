@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDefaultName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "OCM_ADDONS_PAGER", EnvDefaultName("", "pager"))
+	assert.Equal(t, "OCM_ADDONS_TIME_ZONE", EnvDefaultName("", "timeZone"))
+	assert.Equal(t, "OCM_ADDONS_CLUSTER_EVENTS_COLUMNS", EnvDefaultName("cluster events", "columns"))
+	assert.Equal(t, "OCM_ADDONS_LIMITED_SUPPORT_LIST_ORDER", EnvDefaultName("limited-support list", "order"))
+}
+
+func TestCommandDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := PluginConfig{
+		Defaults: CommandDefaults{Pager: "less", TimeZone: "UTC", Columns: "id"},
+		Commands: map[string]CommandDefaults{
+			"cluster events": {Columns: "timestamp, summary", Order: "ascending"},
+		},
+	}
+
+	env := map[string]string{
+		"OCM_ADDONS_PAGER":                 "more",
+		"OCM_ADDONS_ORDER":                 "descending",
+		"OCM_ADDONS_CLUSTER_EVENTS_ORDER":  "ascending",
+		"OCM_ADDONS_NOTIFY_CONCURRENCY":    "8",
+		"OCM_ADDONS_LIST_COLUMNS":          "name",
+		"OCM_ADDONS_UNRELATED_CONCURRENCY": "not-a-number",
+	}
+
+	getenv := func(key string) string { return env[key] }
+
+	defaults, err := cfg.CommandDefaults("cluster events", getenv)
+	require.NoError(t, err)
+	assert.Equal(t, CommandDefaults{
+		Columns:  "timestamp, summary",
+		Order:    "ascending",
+		Pager:    "more",
+		TimeZone: "UTC",
+	}, defaults)
+
+	defaults, err = cfg.CommandDefaults("notify", getenv)
+	require.NoError(t, err)
+	assert.Equal(t, "8", defaults.Concurrency)
+	assert.Equal(t, "id", defaults.Columns)
+
+	_, err = cfg.CommandDefaults("unrelated", getenv)
+	assert.ErrorIs(t, err, errInvalidDefaultValue)
+}
+
+func TestCommandDefaultsSet(t *testing.T) {
+	t.Parallel()
+
+	var defaults CommandDefaults
+
+	require.NoError(t, defaults.Set("timeZone", "Europe/Berlin"))
+	assert.Equal(t, "Europe/Berlin", defaults.TimeZone)
+
+	require.NoError(t, defaults.Set("timeZone", ""))
+	assert.Empty(t, defaults.TimeZone)
+
+	assert.ErrorIs(t, defaults.Set("timeZone", "Nowhere/Special"), errInvalidDefaultValue)
+	assert.ErrorIs(t, defaults.Set("concurrency", "0"), errInvalidDefaultValue)
+	assert.ErrorIs(t, defaults.Set("colour", "red"), errUnknownDefaultKey)
+}
+
+func TestApplyDefaults(t *testing.T) {
+	t.Parallel()
+
+	var (
+		columns     string
+		concurrency int
+		orderBy     string
+		pager       string
+	)
+
+	root := &cobra.Command{Use: "root"}
+	root.PersistentFlags().StringVar(&pager, "pager", "", "")
+
+	child := &cobra.Command{Use: "child", RunE: func(*cobra.Command, []string) error { return nil }}
+	child.Flags().StringVar(&columns, "columns", "id", "")
+	child.Flags().IntVar(&concurrency, "concurrency", 4, "")
+	child.Flags().StringVar(&orderBy, "order-by", "", "")
+
+	root.AddCommand(child)
+
+	assert.Equal(t, "child", CommandPath(child))
+
+	require.NoError(t, ApplyDefaults(child, CommandDefaults{
+		Columns:     "id, name",
+		Concurrency: "8",
+		Order:       "ascending",
+		OrderBy:     "name desc",
+		Pager:       "less",
+	}))
+
+	assert.Equal(t, "id, name", columns)
+	assert.Equal(t, 8, concurrency)
+	assert.Equal(t, "name desc", orderBy)
+	assert.Equal(t, "less", pager)
+	assert.Equal(t, "8", child.Flags().Lookup("concurrency").DefValue)
+
+	root.SetArgs([]string{"child", "--columns", "name"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "name", columns, "flags must take precedence over defaults")
+	assert.Equal(t, 8, concurrency)
+
+	assert.Error(t, ApplyDefaults(child, CommandDefaults{Concurrency: "many"}))
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/spf13/pflag"
+	"go.uber.org/multierr"
+)
+
+// ErrPartialFailure is returned by commands which continued past
+// errors for individual clusters or add-ons.
+var ErrPartialFailure = errors.New("partial failure")
+
+// ErrorOptions control how commands iterating over many clusters or
+// add-ons handle errors for individual items.
+type ErrorOptions struct {
+	ContinueOnError bool
+}
+
+func (o *ErrorOptions) AddContinueOnErrorFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.ContinueOnError,
+		"continue-on-error",
+		o.ContinueOnError,
+		"keeps processing the remaining items when one fails, prints a summary of all failures "+
+			"and exits with status 2",
+	)
+}
+
+// ForEachOption returns the option which applies ContinueOnError
+// to the ForEach of a pager.
+func (o ErrorOptions) ForEachOption() ocm.WithContinueOnError {
+	return ocm.WithContinueOnError(o.ContinueOnError)
+}
+
+// Collect adds err to failures and returns nil when continuing on
+// error. Otherwise err is returned unchanged.
+func (o ErrorOptions) Collect(failures *error, err error) error {
+	if err == nil || !o.ContinueOnError {
+		return err
+	}
+
+	multierr.AppendInto(failures, err)
+
+	return nil
+}
+
+// ReportFailures writes a table summarizing each of the failures to out
+// and returns an error wrapping ErrPartialFailure. Nothing is written and
+// nil is returned if failures is nil.
+func ReportFailures(out io.Writer, failures error, opts ...TableOption) error {
+	if failures == nil {
+		return nil
+	}
+
+	rows := flattenFailures(failures)
+
+	table, err := NewTable(append([]TableOption{
+		WithColumns("kind, id, name, error"),
+		WithOutput{Out: out},
+	}, opts...)...)
+	if err != nil {
+		return fmt.Errorf("initializing failure summary: %w", err)
+	}
+
+	for _, row := range rows {
+		if err := table.Write(row); err != nil {
+			return fmt.Errorf("writing failure summary: %w", err)
+		}
+	}
+
+	if err := table.Flush(); err != nil {
+		return fmt.Errorf("writing failure summary: %w", err)
+	}
+
+	return fmt.Errorf("%d item(s) failed: %w", len(rows), ErrPartialFailure)
+}
+
+// flattenFailures splits combined errors into their ItemErrors. Errors
+// which do not contain an ItemError are kept whole to retain context.
+func flattenFailures(err error) []RowDataProvider {
+	var item *ocm.ItemError
+
+	switch e := err.(type) { //nolint:errorlint
+	case *ocm.ItemError:
+		return []RowDataProvider{e}
+	case interface{ Unwrap() []error }:
+		var rows []RowDataProvider
+
+		for _, child := range e.Unwrap() {
+			rows = append(rows, flattenFailures(child)...)
+		}
+
+		return rows
+	}
+
+	if errors.As(err, &item) {
+		if inner := errors.Unwrap(err); inner != nil {
+			return flattenFailures(inner)
+		}
+	}
+
+	return []RowDataProvider{failureRow{err: err}}
+}
+
+type failureRow struct {
+	err error
+}
+
+func (r failureRow) ProvideRowData() map[string]interface{} {
+	return map[string]interface{}{
+		"Error": r.err.Error(),
+	}
+}
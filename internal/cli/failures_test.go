@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+var errTest = errors.New("test failure")
+
+func TestErrorOptionsCollect(t *testing.T) {
+	t.Parallel()
+
+	var failures error
+
+	assert.ErrorIs(t, ErrorOptions{}.Collect(&failures, errTest), errTest)
+	assert.NoError(t, failures)
+
+	opts := ErrorOptions{ContinueOnError: true}
+
+	assert.NoError(t, opts.Collect(&failures, nil))
+	assert.NoError(t, opts.Collect(&failures, errTest))
+	assert.NoError(t, opts.Collect(&failures, errTest))
+	assert.Len(t, multierr.Errors(failures), 2)
+}
+
+func TestReportFailures(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	require.NoError(t, ReportFailures(&out, nil))
+	assert.Empty(t, out.String())
+
+	failures := multierr.Combine(
+		fmt.Errorf("processing clusters: %w", multierr.Combine(
+			&ocm.ItemError{Kind: "cluster", ID: "id-1", Name: "cluster-1", Err: errTest},
+			&ocm.ItemError{Kind: "cluster", ID: "id-2", Name: "cluster-2", Err: errTest},
+		)),
+		fmt.Errorf("retrieving clusters: %w", errTest),
+	)
+
+	err := ReportFailures(&out, failures, WithNoColor(true))
+	require.ErrorIs(t, err, ErrPartialFailure)
+	assert.Contains(t, err.Error(), "3 item(s) failed")
+
+	summary := out.String()
+
+	assert.Contains(t, summary, "KIND")
+	assert.Contains(t, summary, "cluster-1")
+	assert.Contains(t, summary, "cluster-2")
+	assert.Contains(t, summary, "retrieving clusters: test failure")
+}
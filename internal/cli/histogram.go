@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+func NewHistogram(opts ...HistogramOption) *Histogram {
+	var hist Histogram
+
+	hist.cfg.Option(opts...)
+	hist.cfg.Default()
+
+	return &hist
+}
+
+// Histogram renders labelled values as horizontal bars scaled
+// relative to the largest value.
+type Histogram struct {
+	cfg  HistogramConfig
+	bars []histogramBar
+}
+
+type histogramBar struct {
+	label  string
+	value  int
+	detail string
+}
+
+// Add appends a bar to the histogram. The optional detail is
+// printed after the bar's value.
+func (h *Histogram) Add(label string, value int, detail string) {
+	h.bars = append(h.bars, histogramBar{
+		label:  label,
+		value:  value,
+		detail: detail,
+	})
+}
+
+func (h *Histogram) Flush() error {
+	var (
+		labelWidth int
+		maxValue   int
+	)
+
+	for _, b := range h.bars {
+		if len(b.label) > labelWidth {
+			labelWidth = len(b.label)
+		}
+
+		if b.value > maxValue {
+			maxValue = b.value
+		}
+	}
+
+	var sb strings.Builder
+
+	for _, b := range h.bars {
+		width := 0
+
+		if maxValue > 0 {
+			width = b.value * h.cfg.Width / maxValue
+		}
+
+		if width == 0 && b.value > 0 {
+			width = 1
+		}
+
+		bar := strings.Repeat("█", width)
+		if !h.cfg.NoColor {
+			bar = pterm.FgCyan.Sprint(bar)
+		}
+
+		fmt.Fprintf(&sb, "%-*s %s %d", labelWidth, b.label, bar, b.value)
+
+		if b.detail != "" {
+			fmt.Fprintf(&sb, " (%s)", b.detail)
+		}
+
+		sb.WriteString("\n")
+	}
+
+	if _, err := fmt.Fprint(h.cfg.Out, sb.String()); err != nil {
+		return fmt.Errorf("flushing writer: %w", err)
+	}
+
+	return nil
+}
+
+const defaultHistogramWidth = 40
+
+type HistogramConfig struct {
+	Out     io.Writer
+	NoColor bool
+	Width   int
+}
+
+func (c *HistogramConfig) Option(opts ...HistogramOption) {
+	for _, opt := range opts {
+		opt.ConfigureHistogram(c)
+	}
+}
+
+func (c *HistogramConfig) Default() {
+	if c.Out == nil {
+		c.Out = os.Stdout
+	}
+
+	if c.Width < 1 {
+		c.Width = defaultHistogramWidth
+	}
+}
+
+type HistogramOption interface {
+	ConfigureHistogram(*HistogramConfig)
+}
+
+func (wo WithOutput) ConfigureHistogram(c *HistogramConfig) {
+	c.Out = wo.Out
+}
+
+func (wn WithNoColor) ConfigureHistogram(c *HistogramConfig) {
+	c.NoColor = bool(wn)
+}
+
+type WithWidth int
+
+func (ww WithWidth) ConfigureHistogram(c *HistogramConfig) {
+	c.Width = int(ww)
+}
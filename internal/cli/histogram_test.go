@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramNoColor(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	hist := NewHistogram(WithNoColor(true), WithOutput{Out: &out}, WithWidth(4))
+
+	hist.Add("2022-01-01", 4, "ERROR: 4")
+	hist.Add("2022-01-02", 1, "")
+	hist.Add("2022-01-03", 0, "")
+
+	require.NoError(t, hist.Flush())
+
+	assert.Equal(t, "2022-01-01 ████ 4 (ERROR: 4)\n2022-01-02 █ 1\n2022-01-03  0\n", out.String())
+}
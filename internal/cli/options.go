@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"errors"
 	"strings"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/spf13/pflag"
 )
 
+// errInvalidFlagValue is returned for flag values which fail validation.
+var errInvalidFlagValue = errors.New("invalid flag value")
+
 type CommonOptions struct {
 	Columns   string
 	NoHeaders bool
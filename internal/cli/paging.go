@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/spf13/pflag"
+)
+
+// PagingOptions control how list commands page through the items
+// requested from OCM.
+type PagingOptions struct {
+	PageSize int
+	Limit    int
+	OrderBy  string
+}
+
+func (o *PagingOptions) AddPageSizeFlag(flags *pflag.FlagSet) {
+	flags.IntVar(
+		&o.PageSize,
+		"page-size",
+		ocm.DefaultPageSize,
+		"number of items requested from OCM per page",
+	)
+}
+
+func (o *PagingOptions) AddLimitFlag(flags *pflag.FlagSet) {
+	flags.IntVar(
+		&o.Limit,
+		"limit",
+		o.Limit,
+		"maximum number of items requested from OCM per environment; '0' requests all items",
+	)
+}
+
+func (o *PagingOptions) AddOrderByFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&o.OrderBy,
+		"order-by",
+		o.OrderBy,
+		"order in which OCM returns items, e.g. 'name desc' or 'creation_timestamp asc'",
+	)
+}
+
+// Paging returns the option which applies the page size, limit and
+// order to the pagers of RetrieveAddons and RetrieveClusters. A page
+// size of zero selects the default page size.
+func (o PagingOptions) Paging() (ocm.WithPaging, error) {
+	if o.PageSize < 0 {
+		return ocm.WithPaging{}, fmt.Errorf(
+			"'--page-size' %d: %w; expected a non-negative integer", o.PageSize, errInvalidFlagValue,
+		)
+	}
+
+	if o.Limit < 0 {
+		return ocm.WithPaging{}, fmt.Errorf(
+			"'--limit' %d: %w; expected a non-negative integer", o.Limit, errInvalidFlagValue,
+		)
+	}
+
+	return ocm.WithPaging{
+		PageSize: o.PageSize,
+		Limit:    o.Limit,
+		Order:    o.OrderBy,
+	}, nil
+}
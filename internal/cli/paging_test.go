@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagingOptionsPaging(t *testing.T) {
+	t.Parallel()
+
+	paging, err := PagingOptions{PageSize: 100, Limit: 10, OrderBy: "name desc"}.Paging()
+	require.NoError(t, err)
+	assert.Equal(t, ocm.WithPaging{PageSize: 100, Limit: 10, Order: "name desc"}, paging)
+
+	_, err = PagingOptions{PageSize: -1}.Paging()
+	assert.ErrorIs(t, err, errInvalidFlagValue)
+
+	_, err = PagingOptions{Limit: -1}.Paging()
+	assert.ErrorIs(t, err, errInvalidFlagValue)
+}
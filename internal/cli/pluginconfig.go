@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPluginConfig names an environment variable holding the path of
+// the plug-in config file to use instead of the default location.
+const EnvPluginConfig = "OCM_ADDONS_CONFIG"
+
+// PluginConfigPath returns the location of the plug-in config file
+// which is read from $OCM_ADDONS_CONFIG if set and otherwise from
+// 'ocm-addons/config.yaml' within the user's configuration location.
+func PluginConfigPath() (string, error) {
+	if path := os.Getenv(EnvPluginConfig); path != "" {
+		return path, nil
+	}
+
+	cfgDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("determining user config directory: %w", err)
+	}
+
+	return filepath.Join(cfgDir, "ocm-addons", "config.yaml"), nil
+}
+
+// LoadPluginConfig loads the plug-in config file. An empty PluginConfig
+// is returned if the file does not exist.
+func LoadPluginConfig() (PluginConfig, error) {
+	path, err := PluginConfigPath()
+	if err != nil {
+		return PluginConfig{}, err
+	}
+
+	return LoadPluginConfigFile(path)
+}
+
+// LoadPluginConfigFile loads the plug-in config from the given path.
+// An empty PluginConfig is returned if the file does not exist.
+func LoadPluginConfigFile(path string) (PluginConfig, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return PluginConfig{}, nil
+	} else if err != nil {
+		return PluginConfig{}, fmt.Errorf("reading plug-in config: %w", err)
+	}
+
+	var cfg PluginConfig
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return PluginConfig{}, fmt.Errorf("parsing plug-in config %q: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// Save writes the plug-in config to the given path creating any
+// missing parent directories.
+func (c PluginConfig) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("encoding plug-in config: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating plug-in config directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("writing plug-in config: %w", err)
+	}
+
+	return nil
+}
+
+// expandHome replaces a leading '~' with the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// PluginConfig holds settings of this plug-in which are kept separate
+// from the 'ocm.json' file managed by the OCM-CLI.
+type PluginConfig struct {
+	// Cache configures caching of add-on data between invocations.
+	Cache CacheSettings `yaml:"cache,omitempty"`
+	// Defaults apply to every command.
+	Defaults CommandDefaults `yaml:"defaults,omitempty"`
+	// Commands maps command paths such as 'cluster events' to
+	// defaults which apply only to that command.
+	Commands map[string]CommandDefaults `yaml:"commands,omitempty"`
+	// Environments maps profile names to OCM environments.
+	Environments map[string]Environment `yaml:"environments,omitempty"`
+	// Retry configures retries of failed OCM requests.
+	Retry RetrySettings `yaml:"retry,omitempty"`
+}
+
+// Environment is a named profile describing an OCM API and the source
+// of the credentials used with it.
+type Environment struct {
+	// URL is the OCM API URL or one of the aliases accepted by ResolveURL.
+	URL string `yaml:"url,omitempty"`
+	// TokenEnv names an environment variable holding a token.
+	TokenEnv string `yaml:"tokenEnv,omitempty"`
+	// TokenFile is the path of a file containing a token.
+	TokenFile string `yaml:"tokenFile,omitempty"`
+	// OCMConfig is the path of an 'ocm.json' file to load credentials from.
+	OCMConfig string `yaml:"ocmConfig,omitempty"`
+}
+
+var errUnknownEnvironment = errors.New("unknown environment")
+
+// Environment returns the profile with the given name. Names of the
+// aliases accepted by ResolveURL which have no profile yield a profile
+// with only the URL set so that credentials are found as usual.
+func (c PluginConfig) Environment(name string) (Environment, error) {
+	if env, ok := c.Environments[name]; ok {
+		env.TokenFile = expandHome(env.TokenFile)
+		env.OCMConfig = expandHome(env.OCMConfig)
+
+		return env, nil
+	}
+
+	if _, ok := _urlAliases[name]; ok {
+		return Environment{URL: name}, nil
+	}
+
+	known := make([]string, 0, len(c.Environments))
+
+	for envName := range c.Environments {
+		known = append(known, envName)
+	}
+
+	sort.Strings(known)
+
+	return Environment{}, fmt.Errorf(
+		"%q: %w; expected a profile [%s] or one of 'production', 'staging', 'integration'",
+		name, errUnknownEnvironment, strings.Join(known, ", "),
+	)
+}
+
+// hasCredentials returns true if the profile names a token or config source.
+func (e Environment) hasCredentials() bool {
+	return e.TokenEnv != "" || e.TokenFile != "" || e.OCMConfig != ""
+}
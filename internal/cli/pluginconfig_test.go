@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginConfigEnvironment(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+environments:
+  prod:
+    url: production
+    tokenEnv: OCM_TOKEN_PROD
+  local:
+    url: http://localhost:8000
+    ocmConfig: ~/local.json
+`), 0o600))
+
+	cfg, err := LoadPluginConfigFile(path)
+	require.NoError(t, err)
+
+	env, err := cfg.Environment("prod")
+	require.NoError(t, err)
+	assert.Equal(t, Environment{URL: "production", TokenEnv: "OCM_TOKEN_PROD"}, env)
+
+	env, err = cfg.Environment("staging")
+	require.NoError(t, err)
+	assert.Equal(t, Environment{URL: "staging"}, env)
+
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	env, err = cfg.Environment("local")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, "local.json"), env.OCMConfig)
+
+	_, err = cfg.Environment("unknown")
+	assert.ErrorIs(t, err, errUnknownEnvironment)
+	assert.Contains(t, err.Error(), "[local, prod]")
+
+	missing, err := LoadPluginConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.NoError(t, err)
+	assert.Empty(t, missing.Environments)
+}
@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/pterm/pterm"
+	"github.com/spf13/pflag"
+)
+
+// ProgressOptions control the progress indicator written to standard
+// error by commands which visit many clusters.
+type ProgressOptions struct {
+	NoProgress bool
+}
+
+// GlobalProgressOptions are used by every progress indicator. The root
+// command binds its persistent flags to them.
+var GlobalProgressOptions ProgressOptions
+
+func (o *ProgressOptions) AddNoProgressFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&o.NoProgress,
+		"no-progress",
+		o.NoProgress,
+		"hides the progress indicator shown on standard error while visiting many clusters",
+	)
+}
+
+// NewProgress returns a Progress which reports on a single line of out
+// the pages fetched, the clusters processed, the errors so far and the
+// estimated time remaining. Nothing is reported if out is not a
+// terminal, the '--no-progress' flag is given or a WithMachineReadable
+// option is set.
+func NewProgress(out io.Writer, opts ...ProgressOption) *Progress {
+	var cfg ProgressConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	return &Progress{
+		cfg:     cfg,
+		out:     out,
+		enabled: !GlobalProgressOptions.NoProgress && !cfg.MachineReadable && !cfg.PagedOutput && cfg.IsTerminal(out),
+	}
+}
+
+// Progress implements ocm.Progress. A single Progress may be shared by
+// the iterations over several environments.
+type Progress struct {
+	cfg     ProgressConfig
+	out     io.Writer
+	enabled bool
+	started time.Time
+	drawn   time.Time
+	frame   int
+	pages   int
+	total   int
+	done    int
+	failed  int
+}
+
+// ForEachOption returns the option which reports the progress of a
+// pager's ForEach or which reports nothing if progress is disabled.
+func (p *Progress) ForEachOption() ocm.WithProgress {
+	if !p.enabled {
+		return ocm.WithProgress{}
+	}
+
+	return ocm.WithProgress{Progress: p}
+}
+
+func (p *Progress) PageFetched(page, total int) {
+	if p.started.IsZero() {
+		p.started = p.cfg.Now()
+	}
+
+	p.pages++
+
+	// Totals are summed as each iteration starts so that
+	// several environments add up to a single total.
+	if page == 1 {
+		p.total += total
+	}
+
+	p.draw(true)
+}
+
+func (p *Progress) ItemDone(err error) {
+	p.done++
+
+	if err != nil {
+		p.failed++
+	}
+
+	p.draw(false)
+}
+
+// Stop clears the progress line. It must be called before writing
+// further output to the terminal.
+func (p *Progress) Stop() {
+	if p.drawn.IsZero() {
+		return
+	}
+
+	pterm.Fprinto(p.out, _eraseLine)
+
+	p.drawn = time.Time{}
+}
+
+// _progressInterval limits how often the progress line is redrawn.
+const _progressInterval = 100 * time.Millisecond
+
+// _eraseLine erases from the cursor to the end of the line.
+const _eraseLine = "\x1b[K"
+
+func (p *Progress) draw(force bool) {
+	if !p.enabled {
+		return
+	}
+
+	now := p.cfg.Now()
+	if !force && now.Sub(p.drawn) < _progressInterval {
+		return
+	}
+
+	p.drawn = now
+
+	sequence := pterm.DefaultSpinner.Sequence
+	p.frame = (p.frame + 1) % len(sequence)
+
+	pterm.Fprinto(p.out, pterm.FgCyan.Sprint(sequence[p.frame])+" "+p.status(now)+_eraseLine)
+}
+
+// status summarizes the progress, e.g. 'fetched 2 page(s), processed
+// 60 of 120 clusters, 1 error(s), 30s remaining'.
+func (p *Progress) status(now time.Time) string {
+	parts := []string{fmt.Sprintf("fetched %d page(s)", p.pages)}
+
+	if p.total > 0 {
+		parts = append(parts, fmt.Sprintf("processed %d of %d clusters", p.done, p.total))
+	} else {
+		parts = append(parts, fmt.Sprintf("processed %d clusters", p.done))
+	}
+
+	parts = append(parts, fmt.Sprintf("%d error(s)", p.failed))
+
+	if remaining, ok := p.remaining(now); ok {
+		parts = append(parts, remaining.String()+" remaining")
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// remaining estimates the time needed for the remaining clusters
+// from the average time taken by the clusters processed so far.
+func (p *Progress) remaining(now time.Time) (time.Duration, bool) {
+	if p.done == 0 || p.total <= p.done {
+		return 0, false
+	}
+
+	perItem := now.Sub(p.started) / time.Duration(p.done)
+
+	return (perItem * time.Duration(p.total-p.done)).Round(time.Second), true
+}
+
+type ProgressConfig struct {
+	// MachineReadable disables progress as the command writes
+	// output meant for other programs.
+	MachineReadable bool
+	// PagedOutput disables progress as the command's output is
+	// shown by a pager which shares the terminal.
+	PagedOutput bool
+	Now         func() time.Time
+	IsTerminal  func(io.Writer) bool
+}
+
+func (c *ProgressConfig) Option(opts ...ProgressOption) {
+	for _, opt := range opts {
+		opt.ConfigureProgress(c)
+	}
+}
+
+func (c *ProgressConfig) Default() {
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+
+	if c.IsTerminal == nil {
+		c.IsTerminal = isTerminal
+	}
+}
+
+type ProgressOption interface {
+	ConfigureProgress(*ProgressConfig)
+}
+
+// WithMachineReadable disables progress for commands writing
+// output meant for other programs, e.g. JSON.
+type WithMachineReadable bool
+
+func (wm WithMachineReadable) ConfigureProgress(c *ProgressConfig) {
+	c.MachineReadable = bool(wm)
+}
+
+// WithPagedOutput disables progress for commands whose output is
+// shown by a pager, which the progress line would garble.
+type WithPagedOutput bool
+
+func (wp WithPagedOutput) ConfigureProgress(c *ProgressConfig) {
+	c.PagedOutput = bool(wp)
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return false
+	}
+
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProgressDisabled(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Options  []ProgressOption
+		Expected bool
+	}{
+		"not a terminal": {},
+		"terminal": {
+			Options:  []ProgressOption{withTerminal(true)},
+			Expected: true,
+		},
+		"machine readable": {
+			Options: []ProgressOption{withTerminal(true), WithMachineReadable(true)},
+		},
+		"paged output": {
+			Options: []ProgressOption{withTerminal(true), WithPagedOutput(true)},
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+
+			progress := NewProgress(&out, tc.Options...)
+
+			assert.Equal(t, tc.Expected, progress.ForEachOption().Progress != nil)
+
+			progress.PageFetched(1, 10)
+			progress.ItemDone(nil)
+			progress.Stop()
+
+			if !tc.Expected {
+				assert.Empty(t, out.String())
+			}
+		})
+	}
+}
+
+// withTerminal treats any writer as a terminal.
+type withTerminal bool
+
+func (wt withTerminal) ConfigureProgress(c *ProgressConfig) {
+	c.IsTerminal = func(io.Writer) bool { return bool(wt) }
+}
+
+func TestProgressStatus(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+
+	progress := &Progress{cfg: ProgressConfig{Now: func() time.Time { return now }}}
+
+	assert.Equal(t, "fetched 0 page(s), processed 0 clusters, 0 error(s)", progress.status(now))
+
+	progress.PageFetched(1, 80)
+
+	now = start.Add(20 * time.Second)
+
+	for i := 0; i < 20; i++ {
+		progress.ItemDone(nil)
+	}
+
+	progress.ItemDone(errors.New("failed"))
+
+	assert.Equal(t,
+		"fetched 1 page(s), processed 21 of 80 clusters, 1 error(s), 56s remaining",
+		progress.status(now),
+	)
+
+	progress.PageFetched(2, 80)
+	progress.PageFetched(1, 20)
+
+	require.Equal(t, 100, progress.total, "should sum the totals of separate iterations")
+	assert.Equal(t, 3, progress.pages)
+}
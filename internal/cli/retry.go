@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/spf13/pflag"
+)
+
+// EnvMaxAttempts names an environment variable holding the maximum
+// number of attempts made per OCM request.
+const EnvMaxAttempts = "OCM_ADDONS_MAX_ATTEMPTS"
+
+// RetrySettings configure retries of failed OCM requests in the
+// plug-in config.
+type RetrySettings struct {
+	// MaxAttempts is the maximum number of attempts made per request.
+	MaxAttempts int `yaml:"maxAttempts,omitempty"`
+}
+
+// RetryOptions control how often failed OCM requests are retried.
+type RetryOptions struct {
+	MaxAttempts int
+}
+
+// GlobalRetryOptions are used by every session. The root command
+// binds its persistent flags to them.
+var GlobalRetryOptions RetryOptions
+
+func (o *RetryOptions) AddMaxAttemptsFlag(flags *pflag.FlagSet) {
+	flags.IntVar(
+		&o.MaxAttempts,
+		"max-attempts",
+		o.MaxAttempts,
+		fmt.Sprintf(
+			"maximum number of attempts made per OCM request which fails with a transient error; "+
+				"'1' disables retries; defaults to $%s, then the 'retry.maxAttempts' of the plug-in config and then %d",
+			EnvMaxAttempts, ocm.DefaultMaxAttempts,
+		),
+	)
+}
+
+// RetryPolicy returns the retry policy selected by the options, the
+// environment and the plug-in config in that order of precedence.
+func (o RetryOptions) RetryPolicy(cfg PluginConfig, getenv func(string) string) (*ocm.RetryPolicy, error) {
+	attempts, err := o.maxAttempts(cfg, getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	return ocm.NewRetryPolicy(ocm.WithMaxAttempts(attempts)), nil
+}
+
+func (o RetryOptions) maxAttempts(cfg PluginConfig, getenv func(string) string) (int, error) {
+	if o.MaxAttempts != 0 {
+		if o.MaxAttempts < 1 {
+			return 0, fmt.Errorf(
+				"'--max-attempts' %d: %w; expected a positive integer", o.MaxAttempts, errInvalidFlagValue,
+			)
+		}
+
+		return o.MaxAttempts, nil
+	}
+
+	if value := getenv(EnvMaxAttempts); value != "" {
+		attempts, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("$%s %q: %w", EnvMaxAttempts, value, errInvalidDefaultValue)
+		}
+
+		return validMaxAttempts("$"+EnvMaxAttempts, attempts)
+	}
+
+	if cfg.Retry.MaxAttempts != 0 {
+		return validMaxAttempts("retry.maxAttempts", cfg.Retry.MaxAttempts)
+	}
+
+	return ocm.DefaultMaxAttempts, nil
+}
+
+func validMaxAttempts(source string, attempts int) (int, error) {
+	if attempts < 1 {
+		return 0, fmt.Errorf("%s %d: %w; expected a positive integer", source, attempts, errInvalidDefaultValue)
+	}
+
+	return attempts, nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryOptionsMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	cfg := PluginConfig{Retry: RetrySettings{MaxAttempts: 6}}
+	env := func(key string) string {
+		return map[string]string{EnvMaxAttempts: "2"}[key]
+	}
+
+	attempts, err := RetryOptions{}.maxAttempts(PluginConfig{}, noEnv)
+	require.NoError(t, err)
+	assert.Equal(t, ocm.DefaultMaxAttempts, attempts)
+
+	attempts, err = RetryOptions{}.maxAttempts(cfg, noEnv)
+	require.NoError(t, err)
+	assert.Equal(t, 6, attempts)
+
+	attempts, err = RetryOptions{}.maxAttempts(cfg, env)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	policy, err := RetryOptions{MaxAttempts: 1}.RetryPolicy(cfg, env)
+	require.NoError(t, err)
+	assert.Equal(t, 1, policy.MaxAttempts())
+
+	_, err = RetryOptions{MaxAttempts: -1}.maxAttempts(cfg, env)
+	assert.ErrorIs(t, err, errInvalidFlagValue)
+
+	_, err = RetryOptions{}.maxAttempts(PluginConfig{Retry: RetrySettings{MaxAttempts: -1}}, noEnv)
+	assert.ErrorIs(t, err, errInvalidDefaultValue)
+
+	_, err = RetryOptions{}.maxAttempts(PluginConfig{}, func(string) string { return "many" })
+	assert.ErrorIs(t, err, errInvalidDefaultValue)
+}
+
+func TestNewConnectionDisablesSDKRetries(t *testing.T) {
+	t.Parallel()
+
+	conn, err := newConnection(&config.Config{
+		URL:          "https://api.example.com",
+		TokenURL:     "https://sso.example.com/token",
+		ClientID:     "client",
+		ClientSecret: "secret",
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	assert.Zero(t, conn.RetryLimit())
+}
+
+func TestNewConnectionNotLoggedIn(t *testing.T) {
+	t.Parallel()
+
+	_, err := newConnection(&config.Config{URL: "https://api.example.com"})
+	assert.ErrorIs(t, err, errNotLoggedIn)
+}
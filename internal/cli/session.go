@@ -9,54 +9,300 @@ package cli
 
 import (
 	"errors"
+	"fmt"
+	"os"
 
 	"github.com/apex/log"
-	"github.com/openshift-online/ocm-cli/pkg/ocm"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/debug"
+	"github.com/openshift-online/ocm-cli/pkg/info"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 )
 
 var ErrNoConfigurationLoaded = errors.New("no configuration loaded")
 
-// NewSession loads an ocm configuration created after the user runs 'ocm login'
-// and starts a connection to ocm. A logger with session context is also made
-// available to callers of NewSession. An error is returned if a configuration
-// cannot be loaded or if a connection cannot be made to OCM. Otherwise a
-// pointer to a session object is returned.
+var errNotLoggedIn = errors.New("not logged in")
+
+var errMultipleEnvironments = errors.New("command does not support more than one environment")
+
+// NewSession starts a connection to OCM using credentials from, in order
+// of precedence, the '--token' flag, the environment profile selected with
+// '--env', $OCM_TOKEN or the ocm configuration created after the user runs
+// 'ocm login'. The configuration is read from $OCM_CONFIG if set. A logger
+// with session context is also made available to callers of NewSession.
+// An error is returned if more than one environment is selected, if no
+// usable credentials are found or if a connection cannot be made to OCM.
+// Otherwise a session object is returned.
 func NewSession() (Session, error) {
-	config, err := LoadConfig()
+	envs := GlobalSessionOptions.Environments()
+	if len(envs) > 1 {
+		return Session{}, fmt.Errorf("%q: %w", GlobalSessionOptions.Env, errMultipleEnvironments)
+	}
+
+	sessions, err := NewSessions()
 	if err != nil {
 		return Session{}, err
 	}
 
-	if config.IsEmpty() {
-		return Session{}, ErrNoConfigurationLoaded
+	return sessions[0], nil
+}
+
+// NewSessions starts one session for each environment selected with
+// '--env' or a single session if no environment was selected. If any
+// session cannot be started those already started are ended.
+func NewSessions() ([]Session, error) {
+	pluginCfg, err := LoadPluginConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := GlobalCacheOptions.Cache(pluginCfg, os.Getenv)
+	if err != nil {
+		return nil, fmt.Errorf("configuring cache: %w", err)
+	}
+
+	retryPolicy, err := GlobalRetryOptions.RetryPolicy(pluginCfg, os.Getenv)
+	if err != nil {
+		return nil, fmt.Errorf("configuring retries: %w", err)
+	}
+
+	clientOpts := clientOptions{cache: cache, retry: retryPolicy}
+
+	envs := GlobalSessionOptions.Environments()
+	if len(envs) == 0 {
+		sess, err := newSession("", Environment{}, clientOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		return []Session{sess}, nil
+	}
+
+	sessions := make([]Session, 0, len(envs))
+
+	for _, name := range envs {
+		env, err := pluginCfg.Environment(name)
+		if err == nil {
+			var sess Session
+
+			if sess, err = newSession(name, env, clientOpts); err == nil {
+				sessions = append(sessions, sess)
+
+				continue
+			}
+		}
+
+		EndSessions(sessions)
+
+		return nil, fmt.Errorf("environment %q: %w", name, err)
+	}
+
+	return sessions, nil
+}
+
+// EndSessions ends each of the given sessions.
+func EndSessions(sessions []Session) {
+	for i := range sessions {
+		sessions[i].End()
 	}
+}
+
+// clientOptions are shared by the sessions of an invocation.
+type clientOptions struct {
+	cache *ocm.Cache
+	retry *ocm.RetryPolicy
+}
+
+func newSession(name string, env Environment, clientOpts clientOptions) (Session, error) {
+	var (
+		loaded   *config.Config
+		location string
+	)
+
+	if env.OCMConfig != "" {
+		cfg, err := loadOCMConfigFile(env.OCMConfig)
+		if err != nil {
+			return Session{}, err
+		}
+
+		loaded, location = cfg, fmt.Sprintf("config file %q", env.OCMConfig)
+	} else {
+		cfg, err := LoadConfig()
+		if err != nil {
+			return Session{}, err
+		}
 
-	conn, err := ocm.NewConnection().Build()
+		loaded, location = cfg.cfg, configLocation()
+	}
+
+	creds, err := resolveCredentials(GlobalSessionOptions, env, os.Getenv, loaded, location)
 	if err != nil {
 		return Session{}, err
 	}
 
+	config := Config{cfg: creds.cfg}
+
+	if config.IsEmpty() {
+		return Session{}, fmt.Errorf(
+			"%w from %s; run 'ocm login', set $%s or pass '--token'",
+			ErrNoConfigurationLoaded, creds.source, EnvToken,
+		)
+	}
+
+	conn, err := newConnection(creds.cfg)
+	if err != nil {
+		return Session{}, fmt.Errorf("connecting with credentials from %s: %w", creds.source, err)
+	}
+
+	fields := log.Fields{
+		"ocm_url": config.URL(),
+	}
+
+	if name != "" {
+		fields["environment"] = name
+	}
+
+	logger := log.WithFields(fields)
+
+	logger.
+		WithField("credentials", creds.source).
+		Info("using OCM credentials")
+
 	return Session{
-		config: config,
-		conn:   conn,
-		logger: log.WithFields(log.Fields{
-			"ocm_url": config.URL(),
-		}),
+		cache:            clientOpts.cache,
+		config:           config,
+		conn:             conn,
+		credentialSource: creds.source,
+		environment:      name,
+		logger:           logger,
+		retry:            clientOpts.retry,
 	}, nil
 }
 
+// newConnection builds a connection to OCM from cfg in the same way
+// as the OCM-CLI, except that the SDK does not retry requests itself.
+// Requests are instead retried by the session's ocm.RetryPolicy so that
+// '--max-attempts' bounds the total number of attempts.
+func newConnection(cfg *config.Config) (*sdk.Connection, error) {
+	armed, reason, err := cfg.Armed()
+	if err != nil {
+		return nil, err
+	}
+
+	if !armed {
+		return nil, fmt.Errorf("%w, %s, run 'ocm login'", errNotLoggedIn, reason)
+	}
+
+	logger := sdk.NewGlogLoggerBuilder().DebugV(1).InfoV(1).WarnV(1)
+	if debug.Enabled() {
+		logger.DebugV(0).InfoV(0).WarnV(0)
+	}
+
+	glogger, err := logger.Build()
+	if err != nil {
+		return nil, fmt.Errorf("building logger: %w", err)
+	}
+
+	builder := sdk.NewConnectionBuilder().
+		Logger(glogger).
+		Agent("OCM-CLI/" + info.Version).
+		Insecure(cfg.Insecure).
+		RetryLimit(0)
+
+	if cfg.URL != "" {
+		builder.URL(cfg.URL)
+	}
+
+	if cfg.TokenURL != "" {
+		builder.TokenURL(cfg.TokenURL)
+	}
+
+	if cfg.ClientID != "" || cfg.ClientSecret != "" {
+		builder.Client(cfg.ClientID, cfg.ClientSecret)
+	}
+
+	if cfg.Scopes != nil {
+		builder.Scopes(cfg.Scopes...)
+	}
+
+	if cfg.User != "" || cfg.Password != "" {
+		builder.User(cfg.User, cfg.Password)
+	}
+
+	tokens := make([]string, 0, 2)
+
+	if cfg.AccessToken != "" {
+		tokens = append(tokens, cfg.AccessToken)
+	}
+
+	if cfg.RefreshToken != "" {
+		tokens = append(tokens, cfg.RefreshToken)
+	}
+
+	if len(tokens) > 0 {
+		builder.Tokens(tokens...)
+	}
+
+	return builder.Build()
+}
+
 // Session provides access to the session-bound parameters
 // for an invocation of this plug-in.
 type Session struct {
-	config Config
-	conn   *sdk.Connection
-	logger log.Interface
+	cache            *ocm.Cache
+	config           Config
+	conn             *sdk.Connection
+	credentialSource string
+	environment      string
+	logger           log.Interface
+	retry            *ocm.RetryPolicy
+}
+
+// Environment returns the name of the environment profile of the
+// current session or an empty string if none was selected.
+func (s *Session) Environment() string {
+	return s.environment
+}
+
+// WithEnvironment adds the name of the session's environment to
+// a table row as the 'environment' field.
+func (s *Session) WithEnvironment() RowModifier {
+	return WithAdditionalFields(map[string]interface{}{
+		"environment": s.environment,
+	})
+}
+
+// CredentialSource describes where the credentials of the current
+// session were found, e.g. "$OCM_TOKEN".
+func (s *Session) CredentialSource() string {
+	return s.credentialSource
 }
 
 // Pager returns the pager binary loaded for the current session.
+// The '--pager' flag takes precedence over the OCM config and the
+// value 'none' disables paging.
 func (s *Session) Pager() string {
-	return s.config.Pager()
+	switch pager := GlobalOutputOptions.Pager; pager {
+	case "":
+		return s.config.Pager()
+	case pagerNone:
+		return ""
+	default:
+		return pager
+	}
+}
+
+// CacheOption returns an option which applies the response cache of
+// the current session, if caching is enabled, to add-ons and clusters.
+func (s *Session) CacheOption() ocm.WithCache {
+	return ocm.WithCache{Cache: s.cache}
+}
+
+// RetryOption returns an option which applies the retry policy of
+// the current session to requests made for add-ons and clusters.
+func (s *Session) RetryOption() ocm.WithRetryPolicy {
+	return ocm.WithRetryPolicy{Policy: s.retry}
 }
 
 // Conn returns the OCM connection for the current session.
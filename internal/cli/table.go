@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/pterm/pterm"
 	"go.uber.org/multierr"
@@ -25,6 +26,15 @@ func NewTable(opts ...TableOption) (*Table, error) {
 	table.cfg.Option(opts...)
 	table.cfg.Default()
 
+	if table.cfg.Location == nil {
+		loc, err := GlobalOutputOptions.Location()
+		if err != nil {
+			return nil, err
+		}
+
+		table.cfg.Location = loc
+	}
+
 	if table.cfg.PagerBin != "" {
 		var err error
 
@@ -44,9 +54,10 @@ func NewTable(opts ...TableOption) (*Table, error) {
 }
 
 type Table struct {
-	cfg   TableConfig
-	data  [][]string
-	pager *Pager
+	cfg     TableConfig
+	data    [][]string
+	flushed bool
+	pager   *Pager
 }
 
 func (t *Table) writeHeaders() {
@@ -70,6 +81,14 @@ func (t *Table) Write(r RowDataProvider, mods ...RowModifier) error {
 		row = mod(row)
 	}
 
+	if t.cfg.Location != nil {
+		for name, val := range row {
+			if ts, ok := val.(time.Time); ok && !ts.IsZero() {
+				row[name] = ts.In(t.cfg.Location)
+			}
+		}
+	}
+
 	values := make([]string, 0, len(t.cfg.Columns))
 
 	for _, col := range t.cfg.Columns {
@@ -81,7 +100,15 @@ func (t *Table) Write(r RowDataProvider, mods ...RowModifier) error {
 	return nil
 }
 
+// Flush renders the table to the configured output and closes any
+// pager. Calls after the first have no effect.
 func (t *Table) Flush() error {
+	if t.flushed {
+		return nil
+	}
+
+	t.flushed = true
+
 	var errCollector error
 
 	if err := t.flush(); err != nil {
@@ -130,6 +157,7 @@ type TableConfig struct {
 	Out        io.Writer
 	Columns    []string
 	HFormatter HeaderFormatter
+	Location   *time.Location
 	NoColor    bool
 	NoHeaders  bool
 	PagerBin   string
@@ -167,6 +195,14 @@ func (wc WithColumns) ConfigureTable(c *TableConfig) {
 	c.Columns = strings.Split(string(wc), ",")
 }
 
+// WithLocation displays timestamps in the given time zone instead
+// of the one selected with '--time-zone'.
+type WithLocation struct{ *time.Location }
+
+func (wl WithLocation) ConfigureTable(c *TableConfig) {
+	c.Location = wl.Location
+}
+
 type WithHeaderFormatter HeaderFormatter
 
 func (wh WithHeaderFormatter) ConfigureTable(c *TableConfig) {
@@ -227,6 +263,23 @@ func WithAdditionalFields(fields map[string]interface{}) RowModifier {
 	}
 }
 
+// EnvironmentColumns prepends an 'environment' column to the given
+// comma separated columns if rows from more than one session will be
+// written and the column is not already present.
+func EnvironmentColumns(columns string, sessions []Session) string {
+	if len(sessions) < 2 { //nolint:gomnd
+		return columns
+	}
+
+	for _, col := range strings.Split(columns, ",") {
+		if Normalize(col) == "environment" {
+			return columns
+		}
+	}
+
+	return "environment, " + columns
+}
+
 type HeaderFormatter func(string) string
 
 func UpperSnake(header string) string {
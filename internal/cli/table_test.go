@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentColumns(t *testing.T) {
+	t.Parallel()
+
+	single := make([]Session, 1)
+	multiple := make([]Session, 2)
+
+	assert.Equal(t, "id, name", EnvironmentColumns("id, name", single))
+	assert.Equal(t, "environment, id, name", EnvironmentColumns("id, name", multiple))
+	assert.Equal(t, "id, Environment", EnvironmentColumns("id, Environment", multiple))
+}
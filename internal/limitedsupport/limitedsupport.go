@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package limitedsupport provides access to the catalog of limited
+// support reasons which is embedded in the plug-in binary.
+package limitedsupport
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mt-sre/ocm-addons/internal/catalog"
+)
+
+var _validator = validator.New()
+
+// ReasonTree abstracts limited support reason configs loaded from a filesystem.
+type ReasonTree map[string]map[string]map[string]Config
+
+// GetAllReasons returns a copy of the ReasonTree in native values.
+func (t ReasonTree) GetAllReasons() map[string]map[string]map[string]Config {
+	result := make(map[string]map[string]map[string]Config)
+
+	for team, teamTree := range t {
+		result[team] = make(map[string]map[string]Config)
+
+		for product, productTree := range teamTree {
+			result[team][product] = make(map[string]Config)
+
+			for id, cfg := range productTree {
+				result[team][product][id] = cfg
+			}
+		}
+	}
+
+	return result
+}
+
+// GetReason returns a Config and the value 'true' if a config exists
+// for the given combination of 'team', 'product' and 'id'. Otherwise, an
+// empty Config value is returned along with 'false'.
+func (t ReasonTree) GetReason(team, product, id string) (Config, bool) {
+	cfg, ok := t[team][product][id]
+
+	return cfg, ok
+}
+
+// GetAllReasons returns all limited support reasons loaded from the
+// data directory within this package as a native map.
+func GetAllReasons() map[string]map[string]map[string]Config {
+	return _reasons.GetAllReasons()
+}
+
+// GetReason returns a Config and the value 'true' if a config exists
+// for the given combination of 'team', 'product' and 'id' from the
+// data directory within this package. Otherwise, an empty Config
+// value is returned along with 'false'.
+func GetReason(team, product, id string) (Config, bool) {
+	return _reasons.GetReason(team, product, id)
+}
+
+const _dataDirName = "data"
+
+//go:embed data
+var _dataDir embed.FS
+
+var _reasons ReasonTree
+
+func init() { //nolint:gochecknoinits
+	var err error
+
+	_reasons, err = loadReasons(_dataDir)
+	if err != nil {
+		panic(fmt.Sprintf("unable to load limited support reason configs: %v", err))
+	}
+}
+
+func loadReasons(dataDir fs.FS) (ReasonTree, error) {
+	root, err := fs.Sub(dataDir, _dataDirName)
+	if err != nil {
+		return nil, fmt.Errorf("subbing data directory: %w", err)
+	}
+
+	tree, err := catalog.Load(root, func(cfg Config) error {
+		return _validator.Struct(cfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ReasonTree(tree), nil
+}
+
+// Config abstracts configuration values for a limited support
+// reason. Any changes to this struct should be updated in
+// './data/README.md'.
+type Config struct {
+	Summary string `validate:"required"`
+	Details string `validate:"required"`
+}
+
+func (c *Config) ProvideRowData() map[string]interface{} {
+	return map[string]interface{}{
+		"Details": c.Details,
+		"Summary": c.Summary,
+	}
+}
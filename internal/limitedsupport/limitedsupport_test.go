@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package limitedsupport
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReason(t *testing.T) {
+	t.Parallel()
+
+	testFS := fstest.MapFS{
+		"data/test-team/test-product.yaml": {
+			Data: []byte(strings.Join([]string{
+				"---",
+				"test-reason:",
+				"  summary: Test Summary",
+				"  details: Test details",
+			}, "\n")),
+		},
+	}
+
+	tree, err := loadReasons(testFS)
+	require.NoError(t, err)
+
+	cfg, ok := tree.GetReason("test-team", "test-product", "test-reason")
+	assert.True(t, ok)
+	assert.Equal(t, "Test Summary", cfg.Summary)
+	assert.Equal(t, "Test details", cfg.Details)
+
+	_, ok = tree.GetReason("test-team", "test-product", "missing")
+	assert.False(t, ok)
+
+	all := tree.GetAllReasons()
+	assert.Contains(t, all["test-team"]["test-product"], "test-reason")
+}
+
+func TestBadConfigs(t *testing.T) {
+	t.Parallel()
+
+	for name, data := range map[string]string{
+		"top-level list": strings.Join([]string{
+			"---",
+			"- test-reason:",
+			"    summary: Test Summary",
+			"    details: Test details",
+		}, "\n"),
+		"missing details": strings.Join([]string{
+			"---",
+			"test-reason:",
+			"  summary: Test Summary",
+		}, "\n"),
+	} {
+		data := data
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			badFS := fstest.MapFS{
+				"data/test-team/test-product.yaml": {
+					Data: []byte(data),
+				},
+			}
+
+			tree, err := loadReasons(badFS)
+			assert.Error(t, err, tree)
+		})
+	}
+}
+
+func TestEmbeddedReasons(t *testing.T) {
+	t.Parallel()
+
+	assert.NotEmpty(t, GetAllReasons())
+}
+
+func TestConfigInterfaces(t *testing.T) {
+	t.Parallel()
+
+	require.Implements(t, new(cli.RowDataProvider), new(Config))
+}
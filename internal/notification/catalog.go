@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	configcatalog "github.com/mt-sre/ocm-addons/internal/catalog"
+)
+
+// SourceEmbedded identifies notifications compiled into the plug-in.
+const SourceEmbedded = "embedded"
+
+// EnvNotificationsPath names an environment variable holding a list of
+// additional catalog directories separated by the OS path list separator.
+const EnvNotificationsPath = "OCM_ADDONS_NOTIFICATIONS_PATH"
+
+// UserCatalogDir returns the catalog directory within the
+// user's configuration location.
+func UserCatalogDir() (string, error) {
+	cfgDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("determining user config directory: %w", err)
+	}
+
+	return filepath.Join(cfgDir, "ocm-addons", "notifications"), nil
+}
+
+// CatalogDirs returns the external catalog directories to load in order
+// of increasing precedence: the user catalog directory if it exists,
+// followed by the entries of OCM_ADDONS_NOTIFICATIONS_PATH and finally
+// the supplied directories.
+func CatalogDirs(dirs ...string) []string {
+	var result []string
+
+	if userDir, err := UserCatalogDir(); err == nil {
+		if info, err := os.Stat(userDir); err == nil && info.IsDir() {
+			result = append(result, userDir)
+		}
+	}
+
+	for _, dir := range filepath.SplitList(os.Getenv(EnvNotificationsPath)) {
+		if dir == "" {
+			continue
+		}
+
+		result = append(result, dir)
+	}
+
+	return append(result, dirs...)
+}
+
+// Conflict records a notification which is defined by more than
+// one catalog.
+type Conflict struct {
+	Team       string
+	Product    string
+	ID         string
+	Source     string
+	Overridden string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf(
+		"%s/%s/%s from %q overrides definition from %q",
+		c.Team, c.Product, c.ID, c.Source, c.Overridden,
+	)
+}
+
+var errNotADirectory = errors.New("not a directory")
+
+// LoadCatalogs replaces the available notifications with those embedded
+// in the plug-in merged with the catalogs found in the given directories.
+// Directories are applied in order so that later directories take
+// precedence over earlier ones and all of them take precedence over the
+// embedded catalog. Every overridden definition is returned as a Conflict.
+func LoadCatalogs(dirs ...string) ([]Conflict, error) {
+	result := make(ConfigTree)
+	result.merge(_embedded)
+
+	var conflicts []Conflict
+
+	for _, dir := range dirs {
+		tree, err := loadCatalogDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("loading catalog %q: %w", dir, err)
+		}
+
+		conflicts = append(conflicts, result.merge(tree)...)
+	}
+
+	_notificationsMux.Lock()
+	defer _notificationsMux.Unlock()
+
+	_notifications = result
+
+	return conflicts, nil
+}
+
+func loadCatalogDir(dir string) (ConfigTree, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("resolving absolute path: %w", err)
+	}
+
+	info, err := os.Stat(abs)
+	if err != nil {
+		return nil, fmt.Errorf("inspecting directory: %w", err)
+	}
+
+	if !info.IsDir() {
+		return nil, errNotADirectory
+	}
+
+	return loadCatalog(os.DirFS(abs), abs)
+}
+
+// loadCatalog loads every team directory found at the root of the
+// given filesystem and records the source of each config.
+func loadCatalog(root fs.FS, source string) (ConfigTree, error) {
+	tree, err := configcatalog.Load(root, validateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, products := range tree {
+		for _, configs := range products {
+			for id, cfg := range configs {
+				cfg.Source = source
+				configs[id] = cfg
+			}
+		}
+	}
+
+	return ConfigTree(tree), nil
+}
+
+// merge copies all configs from other into the tree replacing any
+// existing definitions and returns a Conflict for each replacement.
+func (t ConfigTree) merge(other ConfigTree) []Conflict {
+	var conflicts []Conflict
+
+	for team, products := range other {
+		if _, ok := t[team]; !ok {
+			t[team] = make(map[string]map[string]Config)
+		}
+
+		for product, configs := range products {
+			if _, ok := t[team][product]; !ok {
+				t[team][product] = make(map[string]Config)
+			}
+
+			for id, cfg := range configs {
+				if existing, ok := t[team][product][id]; ok {
+					conflicts = append(conflicts, Conflict{
+						Team:       team,
+						Product:    product,
+						ID:         id,
+						Source:     cfg.Source,
+						Overridden: existing.Source,
+					})
+				}
+
+				t[team][product][id] = cfg
+			}
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].String() < conflicts[j].String()
+	})
+
+	return conflicts
+}
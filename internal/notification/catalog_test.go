@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCatalogs(t *testing.T) { //nolint:paralleltest
+	t.Cleanup(func() {
+		_, err := LoadCatalogs()
+		require.NoError(t, err)
+	})
+
+	first := writeCatalog(t, "test-team", "test-product", "first")
+	second := writeCatalog(t, "test-team", "test-product", "second")
+
+	conflicts, err := LoadCatalogs(first, second)
+	require.NoError(t, err)
+
+	cfg, ok := GetNotification("test-team", "test-product", "test-notification")
+	require.True(t, ok)
+	assert.Equal(t, "second", cfg.Summary)
+	assert.Equal(t, second, cfg.Source)
+
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, Conflict{
+		Team:       "test-team",
+		Product:    "test-product",
+		ID:         "test-notification",
+		Source:     second,
+		Overridden: first,
+	}, conflicts[0])
+
+	cfg, ok = GetNotification("mtsre", "ocs-converged", "ceph-osd-critically-full")
+	require.True(t, ok, "embedded notifications remain available")
+	assert.Equal(t, SourceEmbedded, cfg.Source)
+
+	_, err = LoadCatalogs(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestCatalogDirs(t *testing.T) { //nolint:paralleltest
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(EnvNotificationsPath, strings.Join([]string{"env-a", "", "env-b"}, string(os.PathListSeparator)))
+
+	assert.Equal(t, []string{"env-a", "env-b", "flag"}, CatalogDirs("flag"))
+
+	userDir, err := UserCatalogDir()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(userDir, 0o755))
+
+	assert.Equal(t, []string{userDir, "env-a", "env-b", "flag"}, CatalogDirs("flag"))
+}
+
+func writeCatalog(t *testing.T, team, product, summary string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	teamDir := filepath.Join(root, team)
+
+	require.NoError(t, os.MkdirAll(teamDir, 0o755))
+
+	data := strings.Join([]string{
+		"---",
+		"test-notification:",
+		"  summary: " + summary,
+		"  description: Test",
+		"  severity: Error",
+	}, "\n")
+
+	require.NoError(t, os.WriteFile(filepath.Join(teamDir, product+".yaml"), []byte(data), 0o600))
+
+	return root
+}
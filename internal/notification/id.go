@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	errInvalidID            = errors.New("invalid notification ID; expected 'team/product/id'")
+	errNotificationNotFound = errors.New("notification not found")
+)
+
+// ID identifies a notification config as 'team/product/id'.
+type ID struct {
+	Team    string
+	Product string
+	ID      string
+}
+
+func (i ID) String() string {
+	return strings.Join([]string{i.Team, i.Product, i.ID}, "/")
+}
+
+// ParseID parses a notification ID of the form 'team/product/id'.
+func ParseID(rawID string) (ID, error) {
+	const numParts = 3
+
+	parsed := strings.SplitN(rawID, "/", numParts)
+
+	if len(parsed) < numParts {
+		return ID{}, errInvalidID
+	}
+
+	return ID{
+		Team:    parsed[0],
+		Product: parsed[1],
+		ID:      parsed[2],
+	}, nil
+}
+
+// Lookup returns the config for the given ID or an error
+// if no such notification exists.
+func Lookup(id ID) (Config, error) {
+	cfg, ok := GetNotification(id.Team, id.Product, id.ID)
+	if !ok {
+		return Config{}, errNotificationNotFound
+	}
+
+	return cfg, nil
+}
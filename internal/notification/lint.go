@@ -0,0 +1,453 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+// FindingLevel classifies a Finding as either an error which would
+// prevent a catalog from loading or a warning about style.
+type FindingLevel string
+
+const (
+	FindingLevelError   FindingLevel = "error"
+	FindingLevelWarning FindingLevel = "warning"
+)
+
+// Finding is a single problem reported while linting a catalog.
+type Finding struct {
+	Path    string
+	Line    int
+	Column  int
+	Level   FindingLevel
+	Message string
+}
+
+func (f Finding) String() string {
+	pos := f.Path
+
+	if f.Line > 0 {
+		pos = fmt.Sprintf("%s:%d:%d", f.Path, f.Line, f.Column)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", pos, f.Level, f.Message)
+}
+
+// Findings is a sortable collection of Finding values.
+type Findings []Finding
+
+// Errors returns the number of findings with the error level.
+func (fds Findings) Errors() int {
+	return fds.count(FindingLevelError)
+}
+
+// Warnings returns the number of findings with the warning level.
+func (fds Findings) Warnings() int {
+	return fds.count(FindingLevelWarning)
+}
+
+func (fds Findings) count(lvl FindingLevel) int {
+	var result int
+
+	for _, f := range fds {
+		if f.Level == lvl {
+			result++
+		}
+	}
+
+	return result
+}
+
+func (fds Findings) sort() {
+	sort.SliceStable(fds, func(i, j int) bool {
+		if fds[i].Path != fds[j].Path {
+			return fds[i].Path < fds[j].Path
+		}
+
+		if fds[i].Line != fds[j].Line {
+			return fds[i].Line < fds[j].Line
+		}
+
+		return fds[i].Column < fds[j].Column
+	})
+}
+
+// MaxSummaryLength is the summary length above which a
+// style warning is reported.
+const MaxSummaryLength = 64
+
+// Linter validates catalog files using the same rules applied when
+// notifications are loaded and additionally reports style problems.
+// Summaries are tracked across every file linted by the same Linter
+// so that duplicates across products can be reported.
+type Linter struct {
+	findings  Findings
+	summaries map[string]summaryLocation
+}
+
+type summaryLocation struct {
+	product string
+	pos     string
+}
+
+// NewLinter returns an empty Linter.
+func NewLinter() *Linter {
+	return &Linter{
+		summaries: make(map[string]summaryLocation),
+	}
+}
+
+// Findings returns all findings reported so far sorted by position.
+func (l *Linter) Findings() Findings {
+	result := make(Findings, len(l.findings))
+	copy(result, l.findings)
+
+	result.sort()
+
+	return result
+}
+
+// LintPath lints either a single catalog file or a catalog directory
+// containing one subdirectory per team. An error is returned only if
+// the path cannot be read.
+func (l *Linter) LintPath(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("inspecting path: %w", err)
+	}
+
+	if !info.IsDir() {
+		return l.LintFile(path)
+	}
+
+	ents, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("reading catalog directory: %w", err)
+	}
+
+	for _, ent := range ents {
+		entPath := filepath.Join(path, ent.Name())
+
+		if !ent.IsDir() {
+			if isConfigFile(ent.Name()) {
+				l.warn(Finding{Path: entPath}, "config files must be placed within a team directory")
+			}
+
+			continue
+		}
+
+		if err := l.lintTeamDir(entPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *Linter) lintTeamDir(dir string) error {
+	ents, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading team directory: %w", err)
+	}
+
+	for _, ent := range ents {
+		if ent.IsDir() || !isConfigFile(ent.Name()) {
+			continue
+		}
+
+		if err := l.LintFile(filepath.Join(dir, ent.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _configFileRE = regexp.MustCompile(`^.*\.ya?ml$`)
+
+func isConfigFile(name string) bool {
+	return _configFileRE.MatchString(name)
+}
+
+// LintFile lints a single catalog file. The product is derived from the
+// file name and the team from the name of the containing directory.
+func (l *Linter) LintFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	var (
+		product = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		team    = filepath.Base(filepath.Dir(path))
+		root    yaml.Node
+	)
+
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		l.error(Finding{Path: path, Line: yamlErrorLine(err)}, err.Error())
+
+		return nil
+	}
+
+	if len(root.Content) == 0 {
+		l.warn(Finding{Path: path}, "file contains no notifications")
+
+		return nil
+	}
+
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		l.error(at(path, doc), "top-level value must be a mapping of notification IDs to configs")
+
+		return nil
+	}
+
+	seen := make(map[string]*yaml.Node)
+
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		keyNode, valNode := doc.Content[i], doc.Content[i+1]
+		id := keyNode.Value
+
+		if first, ok := seen[id]; ok {
+			l.error(at(path, keyNode), fmt.Sprintf("notification %q is already defined on line %d", id, first.Line))
+
+			continue
+		}
+
+		seen[id] = keyNode
+
+		l.lintConfig(path, team+"/"+product, id, keyNode, valNode)
+	}
+
+	return nil
+}
+
+var (
+	_configKeys   = []string{"addons", "dedupeWindow", "description", "internalOnly", "serviceName", "severity", "summary", "variables"}
+	_variableKeys = []string{"default", "description", "required", "type"}
+)
+
+func (l *Linter) lintConfig(path, product, id string, keyNode, valNode *yaml.Node) {
+	if valNode.Kind != yaml.MappingNode {
+		l.error(at(path, valNode), fmt.Sprintf("notification %q must be a mapping", id))
+
+		return
+	}
+
+	l.lintUnknownKeys(path, valNode, _configKeys)
+
+	if vars := lookup(valNode, "variables"); vars != nil && vars.Kind == yaml.MappingNode {
+		for i := 1; i < len(vars.Content); i += 2 {
+			if vars.Content[i].Kind == yaml.MappingNode {
+				l.lintUnknownKeys(path, vars.Content[i], _variableKeys)
+			}
+		}
+	}
+
+	l.lintSummary(path, product, id, valNode)
+
+	var cfg Config
+
+	if err := valNode.Decode(&cfg); err != nil {
+		f := at(path, valNode)
+
+		if line := yamlErrorLine(err); line > 0 {
+			f.Line, f.Column = line, 1
+		}
+
+		l.error(f, fmt.Sprintf("notification %q: %v", id, unwrapAll(err)))
+
+		return
+	}
+
+	if err := _validator.Struct(cfg); err != nil {
+		var verrs validator.ValidationErrors
+
+		if !errors.As(err, &verrs) {
+			l.error(at(path, keyNode), fmt.Sprintf("notification %q: %v", id, err))
+
+			return
+		}
+
+		for _, fe := range verrs {
+			node := lookupNamespace(valNode, fe.Namespace())
+			if node == nil {
+				node = keyNode
+			}
+
+			l.error(at(path, node), fmt.Sprintf("notification %q: %s", id, describeFieldError(fe)))
+		}
+	}
+
+	if err := cfg.validateTemplates(); err != nil {
+		l.error(at(path, keyNode), fmt.Sprintf("notification %q: %v", id, err))
+	}
+}
+
+func (l *Linter) lintSummary(path, product, id string, cfgNode *yaml.Node) {
+	node := lookup(cfgNode, "summary")
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return
+	}
+
+	summary := node.Value
+
+	if len(summary) > MaxSummaryLength {
+		l.warn(at(path, node), fmt.Sprintf(
+			"notification %q: summary is %d characters long; keep it under %d characters",
+			id, len(summary), MaxSummaryLength,
+		))
+	}
+
+	pos := fmt.Sprintf("%s:%d", path, node.Line)
+
+	if first, ok := l.summaries[summary]; ok && first.product != product {
+		l.warn(at(path, node), fmt.Sprintf(
+			"notification %q: summary %q is also used by %s (%s)",
+			id, summary, first.product, first.pos,
+		))
+
+		return
+	}
+
+	if _, ok := l.summaries[summary]; !ok {
+		l.summaries[summary] = summaryLocation{product: product, pos: pos}
+	}
+}
+
+func (l *Linter) lintUnknownKeys(path string, node *yaml.Node, known []string) {
+	for i := 0; i < len(node.Content); i += 2 {
+		key := node.Content[i]
+
+		if !containsString(known, key.Value) {
+			l.warn(at(path, key), fmt.Sprintf(
+				"unknown field %q; expected one of [%s]", key.Value, strings.Join(known, ", "),
+			))
+		}
+	}
+}
+
+func (l *Linter) error(f Finding, msg string) {
+	f.Level = FindingLevelError
+	f.Message = msg
+
+	l.findings = append(l.findings, f)
+}
+
+func (l *Linter) warn(f Finding, msg string) {
+	f.Level = FindingLevelWarning
+	f.Message = msg
+
+	l.findings = append(l.findings, f)
+}
+
+func at(path string, node *yaml.Node) Finding {
+	return Finding{
+		Path:   path,
+		Line:   node.Line,
+		Column: node.Column,
+	}
+}
+
+// lookup returns the value node for the given key of a mapping node
+// comparing keys case-insensitively.
+func lookup(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+var _namespaceSegmentRE = regexp.MustCompile(`[^.\[\]]+`)
+
+// lookupNamespace resolves a validator namespace such as
+// 'Config.Variables[threshold].Type' to the matching node within
+// the config mapping.
+func lookupNamespace(cfgNode *yaml.Node, namespace string) *yaml.Node {
+	segments := _namespaceSegmentRE.FindAllString(namespace, -1)
+	if len(segments) < 2 { //nolint:gomnd
+		return nil
+	}
+
+	node := cfgNode
+
+	for _, seg := range segments[1:] {
+		if node = lookup(node, seg); node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+func describeFieldError(fe validator.FieldError) string {
+	field := strings.ToLower(fe.Field()[:1]) + fe.Field()[1:]
+
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("field %q is required", field)
+	case "oneof":
+		return fmt.Sprintf("field %q must be one of [%s]", field, strings.Join(strings.Fields(fe.Param()), ", "))
+	case "ends-with-alnum":
+		return fmt.Sprintf(
+			"field %q must not end with punctuation or whitespace; a trailing period is added automatically",
+			field,
+		)
+	default:
+		return fmt.Sprintf("field %q failed validation %q", field, fe.Tag())
+	}
+}
+
+var _yamlLineRE = regexp.MustCompile(`line (\d+)`)
+
+func yamlErrorLine(err error) int {
+	match := _yamlLineRE.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+
+	line, _ := strconv.Atoi(match[1])
+
+	return line
+}
+
+func unwrapAll(err error) error {
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return err
+		}
+
+		err = next
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, elem := range ss {
+		if elem == s {
+			return true
+		}
+	}
+
+	return false
+}
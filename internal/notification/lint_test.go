@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinter(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	writeLintFile(t, root, "test-team/first-product.yaml", []string{
+		"---",
+		"first-notification:",
+		"  summary: SharedSummary",
+		"  description: Threshold is {{ .threshold }}",
+		"  severity: Error",
+		"  internalOnly: maybe",
+		"second-notification:",
+		"  summary: " + strings.Repeat("Long", 20),
+		"  description: Test",
+		"  severity: Error",
+		"  variables:",
+		"    threshold:",
+		"      type: duration",
+		"      defualt: 1",
+		"second-notification:",
+		"  summary: Duplicate",
+		"  description: Test",
+		"  severity: Error",
+	})
+	writeLintFile(t, root, "test-team/second-product.yaml", []string{
+		"---",
+		"third-notification:",
+		"  summary: SharedSummary",
+		"  description: Test",
+		"  severity: Error",
+	})
+	writeLintFile(t, root, "orphan.yaml", []string{"---"})
+
+	linter := NewLinter()
+	require.NoError(t, linter.LintPath(root))
+
+	first := filepath.Join(root, "test-team", "first-product.yaml")
+	second := filepath.Join(root, "test-team", "second-product.yaml")
+
+	findings := linter.Findings()
+
+	actual := make([]string, 0, len(findings))
+
+	for _, f := range findings {
+		actual = append(actual, f.String())
+	}
+
+	assert.Equal(t, []string{
+		filepath.Join(root, "orphan.yaml") + ": warning: config files must be placed within a team directory",
+		first + `:6:1: error: notification "first-notification": yaml: unmarshal errors:` +
+			"\n  line 6: cannot unmarshal !!str `maybe` into bool",
+		first + `:8:12: warning: notification "second-notification": summary is 80 characters long; keep it under 64 characters`,
+		first + `:13:13: error: notification "second-notification": field "type" must be one of [string, int, float, bool]`,
+		first + `:14:7: warning: unknown field "defualt"; expected one of [default, description, required, type]`,
+		first + `:15:1: error: notification "second-notification" is already defined on line 7`,
+		second + `:3:12: warning: notification "third-notification": summary "SharedSummary" is also used by test-team/first-product (` +
+			first + ":3)",
+	}, actual)
+	assert.Equal(t, 3, findings.Errors())
+	assert.Equal(t, 4, findings.Warnings())
+}
+
+func TestLinterEmbeddedData(t *testing.T) {
+	t.Parallel()
+
+	linter := NewLinter()
+	require.NoError(t, linter.LintPath(_dataDirName))
+
+	assert.Zero(t, linter.Findings().Errors())
+}
+
+func writeLintFile(t *testing.T, root, name string, data []string) {
+	t.Helper()
+
+	path := filepath.Join(root, name)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(data, "\n")), 0o600))
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"text/template/parse"
+)
+
+// ServiceNames returns the distinct service names used by
+// notifications in the ConfigTree.
+func (t ConfigTree) ServiceNames() []string {
+	seen := make(map[string]struct{})
+
+	for _, products := range t {
+		for _, configs := range products {
+			for _, cfg := range configs {
+				seen[cfg.ServiceName] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(seen))
+
+	for name := range seen {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// Match returns the ID of the first notification, ordered by ID, from
+// which a service log entry with the given service name, summary and
+// description could have been created. The second return value is false
+// if no notification matches.
+func (t ConfigTree) Match(serviceName, summary, description string) (ID, bool) {
+	for _, team := range sortedKeys(t) {
+		for _, product := range sortedKeys(t[team]) {
+			for _, id := range sortedKeys(t[team][product]) {
+				cfg := t[team][product][id]
+
+				if cfg.Matches(serviceName, summary, description) {
+					return ID{Team: team, Product: product, ID: id}, true
+				}
+			}
+		}
+	}
+
+	return ID{}, false
+}
+
+// ServiceNames returns the distinct service names used by all
+// loaded notifications.
+func ServiceNames() []string {
+	return catalog().ServiceNames()
+}
+
+// Match returns the ID of the loaded notification from which a service
+// log entry with the given service name, summary and description could
+// have been created.
+func Match(serviceName, summary, description string) (ID, bool) {
+	return catalog().Match(serviceName, summary, description)
+}
+
+// Matches returns true if a service log entry with the given service name,
+// summary and description could have been created from the config. Template
+// actions within the summary and description match any text.
+func (c *Config) Matches(serviceName, summary, description string) bool {
+	if c.ServiceName != serviceName {
+		return false
+	}
+
+	return templateMatches(c.Summary, summary) && templateMatches(c.Description, description)
+}
+
+func templateMatches(text, rendered string) bool {
+	tmpl, err := parseTemplate("match", text)
+	if err != nil || tmpl.Tree == nil {
+		return text == rendered
+	}
+
+	var pattern strings.Builder
+
+	pattern.WriteString(`(?s)^`)
+
+	for _, node := range tmpl.Tree.Root.Nodes {
+		if textNode, ok := node.(*parse.TextNode); ok {
+			pattern.WriteString(regexp.QuoteMeta(string(textNode.Text)))
+
+			continue
+		}
+
+		pattern.WriteString(`.*?`)
+	}
+
+	pattern.WriteString(`$`)
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return text == rendered
+	}
+
+	return re.MatchString(rendered)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+
+	for k := range m {
+		result = append(result, k)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
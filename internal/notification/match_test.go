@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigTreeMatch(t *testing.T) {
+	t.Parallel()
+
+	tree := ConfigTree{
+		"team-a": {
+			"product-a": {
+				"plain": {
+					ServiceName: "SREManualAction",
+					Summary:     "StorageFull",
+					Description: "Storage is full",
+				},
+				"templated": {
+					ServiceName: "SREManualAction",
+					Summary:     "StorageNearlyFull",
+					Description: "Storage on {{ .clusterName }} is above {{ .threshold }} percent (limit *)",
+				},
+			},
+		},
+		"team-b": {
+			"product-b": {
+				"other-service": {
+					ServiceName: "OtherService",
+					Summary:     "StorageFull",
+					Description: "Storage is full",
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"OtherService", "SREManualAction"}, tree.ServiceNames())
+
+	for name, tc := range map[string]struct {
+		ServiceName string
+		Summary     string
+		Description string
+		Expected    string
+	}{
+		"exact match": {
+			ServiceName: "SREManualAction",
+			Summary:     "StorageFull",
+			Description: "Storage is full",
+			Expected:    "team-a/product-a/plain",
+		},
+		"service name distinguishes": {
+			ServiceName: "OtherService",
+			Summary:     "StorageFull",
+			Description: "Storage is full",
+			Expected:    "team-b/product-b/other-service",
+		},
+		"templated match": {
+			ServiceName: "SREManualAction",
+			Summary:     "StorageNearlyFull",
+			Description: "Storage on my-cluster is above 80 percent (limit *)",
+			Expected:    "team-a/product-a/templated",
+		},
+		"literal text must match": {
+			ServiceName: "SREManualAction",
+			Summary:     "StorageNearlyFull",
+			Description: "Storage on my-cluster is above 80 percent (limit 90)",
+		},
+		"no match": {
+			ServiceName: "SREManualAction",
+			Summary:     "Unknown",
+			Description: "Storage is full",
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, ok := tree.Match(tc.ServiceName, tc.Summary, tc.Description)
+			if tc.Expected == "" {
+				assert.False(t, ok)
+
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, tc.Expected, id.String())
+		})
+	}
+}
@@ -7,11 +7,14 @@ package notification
 import (
 	"embed"
 	"fmt"
-	"io"
 	"io/fs"
-	"path"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/mt-sre/ocm-addons/internal/ocm"
 
 	"github.com/go-playground/validator/v10"
 	"gopkg.in/yaml.v3"
@@ -20,7 +23,8 @@ import (
 var _validator = validator.New()
 
 func init() { //nolint:gochecknoinits
-	endsWithAlNumRE := regexp.MustCompile(`\w$`)
+	// Fields ending with a template action are validated again once rendered.
+	endsWithAlNumRE := regexp.MustCompile(`(\w|\}\})$`)
 
 	if err := _validator.RegisterValidation(
 		"ends-with-alnum", MatchesPattern(endsWithAlNumRE)); err != nil {
@@ -53,7 +57,7 @@ func (t ConfigTree) GetAllNotifications() map[string]map[string]map[string]Confi
 	result := make(map[string]map[string]map[string]Config)
 
 	for team := range t {
-		result[team] = GetTeamNotifications(team)
+		result[team] = t.GetTeamNotifications(team)
 	}
 
 	return result
@@ -138,21 +142,21 @@ func (t ConfigTree) GetNotification(team, product, id string) (Config, bool) {
 // GetAllNotifications returns all notifications loaded from the
 // data directory within this package as a native map.
 func GetAllNotifications() map[string]map[string]map[string]Config {
-	return _notifications.GetAllNotifications()
+	return catalog().GetAllNotifications()
 }
 
 // GetTeamNotifications returns all notifications loaded from the
 // data directory within this package for a particular team as a
 // native map.
 func GetTeamNotifications(team string) map[string]map[string]Config {
-	return _notifications.GetTeamNotifications(team)
+	return catalog().GetTeamNotifications(team)
 }
 
 // GetTeamNotifications returns all notifications loaded from the
 // data directory within this package for a particular team and
 // product as a native map.
 func GetProductNotifications(team, product string) map[string]Config {
-	return _notifications.GetProductNotifications(team, product)
+	return catalog().GetProductNotifications(team, product)
 }
 
 // GetNotification returns a Config and the value 'true' if a config exists
@@ -160,7 +164,7 @@ func GetProductNotifications(team, product string) map[string]Config {
 // data directory within this package. Otherwise, an empty Config
 // value is returned along with 'false'.
 func GetNotification(team, product, id string) (Config, bool) {
-	return _notifications.GetNotification(team, product, id)
+	return catalog().GetNotification(team, product, id)
 }
 
 const _dataDirName = "data"
@@ -168,139 +172,83 @@ const _dataDirName = "data"
 //go:embed data
 var _dataDir embed.FS
 
-var _notifications ConfigTree
+// _embedded holds the notifications compiled into the plug-in while
+// _notifications additionally includes any external catalogs.
+var (
+	_embedded, _notifications ConfigTree
+	_notificationsMux         sync.RWMutex
+)
 
-func init() { //nolint:gochecknoinits
-	var err error
+func catalog() ConfigTree {
+	_notificationsMux.RLock()
+	defer _notificationsMux.RUnlock()
 
-	_notifications, err = loadNotifications(_dataDir)
-	if err != nil {
-		panic(fmt.Sprintf("unable to load customer notifcation configs: %v", err))
-	}
+	return _notifications
 }
 
-func loadNotifications(dataDir fs.ReadDirFS) (ConfigTree, error) {
-	teamDirs, err := getTeamDirectiories(dataDir)
-	if err != nil {
-		return nil, fmt.Errorf("retrieving team directories: %w", err)
-	}
-
-	result := make(ConfigTree)
-
-	for _, dir := range teamDirs {
-		teamName := dir.Name()
-
-		subFS, err := fs.Sub(dataDir, path.Join(_dataDirName, teamName))
-		if err != nil {
-			return nil, fmt.Errorf("subbing team directory: %w", err)
-		}
-
-		teamNotifications, err := loadTeamConfigDirectory(subFS)
-		if err != nil {
-			return nil, fmt.Errorf("loading team config directory %q: %w", teamName, err)
-		}
-
-		result[teamName] = teamNotifications
-	}
-
-	return result, nil
-}
+func init() { //nolint:gochecknoinits
+	var err error
 
-func getTeamDirectiories(rootDir fs.ReadDirFS) ([]fs.DirEntry, error) {
-	ents, err := rootDir.ReadDir(_dataDirName)
+	_embedded, err = loadNotifications(_dataDir)
 	if err != nil {
-		return nil, fmt.Errorf("reading root directory entries: %w", err)
-	}
-
-	result := make([]fs.DirEntry, 0, len(ents))
-
-	for _, ent := range ents {
-		if !ent.IsDir() {
-			continue
-		}
-
-		result = append(result, ent)
+		panic(fmt.Sprintf("unable to load customer notifcation configs: %v", err))
 	}
 
-	return result, nil
+	_notifications = _embedded
 }
 
-func loadTeamConfigDirectory(dir fs.FS) (map[string]map[string]Config, error) {
-	ents, err := fs.ReadDir(dir, ".")
+func loadNotifications(dataDir fs.FS) (ConfigTree, error) {
+	root, err := fs.Sub(dataDir, _dataDirName)
 	if err != nil {
-		return nil, fmt.Errorf("reading team directory: %w", err)
-	}
-
-	extPat := regexp.MustCompile(`^.*\.ya?ml$`)
-
-	result := make(map[string]map[string]Config)
-
-	for _, ent := range ents {
-		name := ent.Name()
-
-		if !extPat.MatchString(name) {
-			continue
-		}
-
-		configFile, err := dir.Open(name)
-		if err != nil {
-			return nil, fmt.Errorf("opening config file %q: %w", name, err)
-		}
-
-		defer configFile.Close()
-
-		configs, err := loadConfigFile(configFile)
-		if err != nil {
-			return nil, fmt.Errorf("loading config file %q: %w", name, err)
-		}
-
-		key := strings.TrimSuffix(name, path.Ext(name))
-
-		result[key] = configs
+		return nil, fmt.Errorf("subbing data directory: %w", err)
 	}
 
-	return result, nil
+	return loadCatalog(root, SourceEmbedded)
 }
 
-func loadConfigFile(f fs.File) (map[string]Config, error) {
-	data, err := io.ReadAll(f)
-	if err != nil {
-		return nil, fmt.Errorf("reading config file: %w", err)
-	}
-
-	result := make(map[string]Config)
-
-	if err := yaml.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("unmarshalling config yaml: %w", err)
+// validateConfig validates a config loaded from a catalog.
+func validateConfig(cfg Config) error {
+	if err := _validator.Struct(cfg); err != nil {
+		return err
 	}
 
-	for id, cfg := range result {
-		if err := _validator.Struct(cfg); err != nil {
-			return nil, fmt.Errorf("validating config %q: %w", id, err)
-		}
+	if err := cfg.validateTemplates(); err != nil {
+		return fmt.Errorf("validating templates: %w", err)
 	}
 
-	return result, nil
+	return nil
 }
 
 // Config abstracts configuration values for a customer
 // notification. Any changes to this struct should be
 // updated in './data/README.md'.
 type Config struct {
-	Description  string `validate:"required,ends-with-alnum"`
-	InternalOnly bool   `yaml:"internalOnly"`
-	ServiceName  string `yaml:"serviceName"`
-	Severity     string `validate:"required,oneof=Debug Error Fatal Info Warning"`
-	Summary      string `validate:"required"`
+	// Addons lists the IDs of add-ons of which at least one must be
+	// installed on a cluster for the notification to apply.
+	Addons       []string            `validate:"dive,required"`
+	Description  string              `validate:"required,ends-with-alnum"`
+	InternalOnly bool                `yaml:"internalOnly"`
+	ServiceName  string              `yaml:"serviceName"`
+	Severity     string              `validate:"required,oneof=Debug Error Fatal Info Warning"`
+	Summary      string              `validate:"required"`
+	Variables    map[string]Variable `validate:"dive"`
+	DedupeWindow time.Duration       `yaml:"dedupeWindow" validate:"gte=0"`
+	// Source is the catalog the config was loaded from.
+	Source string `yaml:"-"`
 }
 
 const defaultServiceName = "SREManualAction"
 
+// DefaultDedupeWindow is the period during which an identical
+// notification previously sent to a cluster blocks sending it again.
+const DefaultDedupeWindow = time.Hour
+
 func (c *Config) UnmarshalYAML(value *yaml.Node) error {
 	type rawConfig Config
 
 	raw := rawConfig{
-		ServiceName: defaultServiceName,
+		ServiceName:  defaultServiceName,
+		DedupeWindow: DefaultDedupeWindow,
 	}
 
 	if err := value.Decode(&raw); err != nil {
@@ -312,12 +260,54 @@ func (c *Config) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// LogEntryOptions returns the options needed to create a
+// service log entry from the config.
+func (c Config) LogEntryOptions() []ocm.LogEntryOption {
+	opts := []ocm.LogEntryOption{
+		ocm.LogEntryDescription(c.Description),
+		ocm.LogEntryServiceName(c.ServiceName),
+		ocm.LogEntrySeverity(c.Severity),
+		ocm.LogEntrySummary(c.Summary),
+	}
+
+	if c.InternalOnly {
+		opts = append(opts, ocm.LogEntryInternalOnly)
+	}
+
+	return opts
+}
+
+// InstalledAddon returns the ID of the first add-on listed in the
+// config's 'addons' which is among the given installed add-on
+// versions keyed by add-on ID. The second return value is false if
+// none of them are installed.
+func (c Config) InstalledAddon(versions map[string]string) (string, bool) {
+	for _, addonID := range c.Addons {
+		if _, ok := versions[addonID]; ok {
+			return addonID, true
+		}
+	}
+
+	return "", false
+}
+
 func (c *Config) ProvideRowData() map[string]interface{} {
+	variables := make([]string, 0, len(c.Variables))
+
+	for name := range c.Variables {
+		variables = append(variables, name)
+	}
+
+	sort.Strings(variables)
+
 	return map[string]interface{}{
+		"Addons":        strings.Join(c.Addons, ","),
 		"Description":   c.Description,
 		"Internal Only": c.InternalOnly,
 		"Service Name":  c.ServiceName,
 		"Severity":      c.Severity,
+		"Source":        c.Source,
 		"Summary":       c.Summary,
+		"Variables":     strings.Join(variables, ","),
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/mt-sre/ocm-addons/internal/cli"
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,75 @@ func TestGetNotification(t *testing.T) {
 	assert.Equal(t, "Error", cfg.Severity)
 	assert.Equal(t, "SREManualAction", cfg.ServiceName)
 	assert.Equal(t, false, cfg.InternalOnly)
+	assert.Equal(t, DefaultDedupeWindow, cfg.DedupeWindow)
+}
+
+func TestDedupeWindow(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Value    string
+		Expected time.Duration
+	}{
+		"minutes": {
+			Value:    "30m",
+			Expected: 30 * time.Minute,
+		},
+		"disabled": {
+			Value:    "0s",
+			Expected: 0,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testFS := fstest.MapFS{
+				"data/test-team/test-product.yaml": {
+					Data: []byte(validConfig() + "\n  dedupeWindow: " + tc.Value),
+				},
+			}
+
+			tree, err := loadNotifications(testFS)
+			require.NoError(t, err)
+
+			cfg, ok := tree.GetNotification("test-team", "test-product", "test-notification")
+			require.True(t, ok)
+			assert.Equal(t, tc.Expected, cfg.DedupeWindow)
+		})
+	}
+}
+
+func TestInstalledAddon(t *testing.T) {
+	t.Parallel()
+
+	testFS := fstest.MapFS{
+		"data/test-team/test-product.yaml": {
+			Data: []byte(validConfig() + "\n  addons: [other-addon, test-addon]"),
+		},
+	}
+
+	tree, err := loadNotifications(testFS)
+	require.NoError(t, err)
+
+	cfg, ok := tree.GetNotification("test-team", "test-product", "test-notification")
+	require.True(t, ok)
+	assert.Equal(t, []string{"other-addon", "test-addon"}, cfg.Addons)
+
+	versions := map[string]string{
+		"unrelated-addon": "1.0.0",
+		"test-addon":      "2.0.0",
+	}
+
+	addonID, ok := cfg.InstalledAddon(versions)
+	require.True(t, ok)
+	assert.Equal(t, "test-addon", addonID)
+
+	delete(versions, "test-addon")
+
+	_, ok = cfg.InstalledAddon(versions)
+	assert.False(t, ok)
 }
 
 func setupTestFS(t *testing.T) fstest.MapFS {
@@ -98,6 +168,9 @@ func TestBadConfigs(t *testing.T) {
 		"invalid description": {
 			Data: invalidDescription(),
 		},
+		"negative dedupe window": {
+			Data: validConfig() + "\n  dedupeWindow: -1h",
+		},
 	} {
 		tc := tc
 
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// Email approximates the email a customer receives for a
+// service log entry created from a notification.
+type Email struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+var _emailHTML = template.Must(template.New("email").Parse(`<html>
+  <body>
+    <h2>{{ .Summary }}</h2>
+    <p><strong>Severity:</strong> {{ .Severity }}</p>
+    <p>{{ .Description }}</p>
+  </body>
+</html>
+`))
+
+// Email returns an approximation of the email sent for the config.
+// As when the email is delivered a trailing period is appended to
+// the description.
+func (c Config) Email() (Email, error) {
+	data := struct {
+		Summary     string
+		Severity    string
+		Description string
+	}{
+		Summary:     c.Summary,
+		Severity:    c.Severity,
+		Description: c.Description + ".",
+	}
+
+	var text strings.Builder
+
+	fmt.Fprintf(&text, "Subject: %s\n", data.Summary)
+	fmt.Fprintf(&text, "Severity: %s\n\n", data.Severity)
+	fmt.Fprintln(&text, data.Description)
+
+	var html bytes.Buffer
+
+	if err := _emailHTML.Execute(&html, data); err != nil {
+		return Email{}, fmt.Errorf("rendering html email: %w", err)
+	}
+
+	return Email{
+		Subject: data.Summary,
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// WritePreview writes the given service log entry JSON followed by
+// the plain-text and HTML approximations of the customer email for
+// the rendered config. No email is shown for internal-only configs
+// as those are never sent to customers.
+func WritePreview(out io.Writer, entryJSON []byte, cfg Config) error {
+	var indented bytes.Buffer
+
+	if err := json.Indent(&indented, entryJSON, "", "  "); err != nil {
+		return fmt.Errorf("indenting log entry: %w", err)
+	}
+
+	fmt.Fprintln(out, "--- Service Log Entry ---")
+	fmt.Fprintln(out, indented.String())
+
+	if cfg.InternalOnly {
+		fmt.Fprintln(out, "--- Email ---")
+		fmt.Fprintln(out, "internal only: no email is sent to the customer")
+
+		return nil
+	}
+
+	email, err := cfg.Email()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "--- Email (plain text, approximate) ---")
+	fmt.Fprint(out, email.Text)
+	fmt.Fprintln(out, "--- Email (HTML, approximate) ---")
+	fmt.Fprint(out, email.HTML)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigEmail(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Description: "Storage is <nearly> full",
+		Severity:    "Warning",
+		Summary:     "StorageNearlyFull",
+	}
+
+	email, err := cfg.Email()
+	require.NoError(t, err)
+
+	assert.Equal(t, "StorageNearlyFull", email.Subject)
+	assert.Equal(t,
+		"Subject: StorageNearlyFull\nSeverity: Warning\n\nStorage is <nearly> full.\n",
+		email.Text,
+	)
+	assert.Contains(t, email.HTML, "<p>Storage is &lt;nearly&gt; full.</p>")
+	assert.Contains(t, email.HTML, "<h2>StorageNearlyFull</h2>")
+}
+
+func TestWritePreview(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Description: "Test",
+		Severity:    "Info",
+		Summary:     "TestSummary",
+	}
+
+	var out bytes.Buffer
+
+	require.NoError(t, WritePreview(&out, []byte(`{"summary":"TestSummary"}`), cfg))
+	assert.Contains(t, out.String(), "{\n  \"summary\": \"TestSummary\"\n}")
+	assert.Contains(t, out.String(), "Email (plain text, approximate)")
+	assert.Contains(t, out.String(), "Email (HTML, approximate)")
+
+	out.Reset()
+
+	cfg.InternalOnly = true
+
+	require.NoError(t, WritePreview(&out, []byte(`{}`), cfg))
+	assert.Contains(t, out.String(), "no email is sent to the customer")
+	assert.NotContains(t, out.String(), "approximate")
+
+	assert.Error(t, WritePreview(&out, []byte(`not json`), cfg))
+}
@@ -0,0 +1,423 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Built-in template variables which are populated from the
+// cluster a notification is sent to.
+const (
+	VarAddonVersion      = "addonVersion"
+	VarClusterExternalID = "clusterExternalID"
+	VarClusterID         = "clusterID"
+	VarClusterName       = "clusterName"
+	VarConsoleURL        = "consoleURL"
+)
+
+// BuiltinVariables returns the names of all variables which are
+// available to notification templates without being declared.
+func BuiltinVariables() []string {
+	return []string{
+		VarAddonVersion,
+		VarClusterExternalID,
+		VarClusterID,
+		VarClusterName,
+		VarConsoleURL,
+	}
+}
+
+func isBuiltinVariable(name string) bool {
+	for _, v := range BuiltinVariables() {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClusterContext provides the values of built-in template variables.
+type ClusterContext struct {
+	AddonVersion      string
+	ClusterExternalID string
+	ClusterID         string
+	ClusterName       string
+	ConsoleURL        string
+}
+
+// PlaceholderClusterContext returns a ClusterContext populated with
+// placeholder values for previewing notifications without a cluster.
+func PlaceholderClusterContext() ClusterContext {
+	return ClusterContext{
+		AddonVersion:      "ADDON_VERSION",
+		ClusterExternalID: "CLUSTER_EXTERNAL_ID",
+		ClusterID:         "CLUSTER_ID",
+		ClusterName:       "CLUSTER_NAME",
+		ConsoleURL:        "CONSOLE_URL",
+	}
+}
+
+func (c ClusterContext) values() map[string]interface{} {
+	return map[string]interface{}{
+		VarAddonVersion:      c.AddonVersion,
+		VarClusterExternalID: c.ClusterExternalID,
+		VarClusterID:         c.ClusterID,
+		VarClusterName:       c.ClusterName,
+		VarConsoleURL:        c.ConsoleURL,
+	}
+}
+
+// Variable declares a template variable which may be supplied
+// when a notification is sent.
+type Variable struct {
+	Type        string `validate:"oneof=string int float bool"`
+	Description string
+	Required    bool
+	Default     string
+}
+
+const defaultVariableType = "string"
+
+func (v *Variable) UnmarshalYAML(value *yaml.Node) error {
+	type rawVariable Variable
+
+	raw := rawVariable{
+		Type: defaultVariableType,
+	}
+
+	if err := value.Decode(&raw); err != nil {
+		return fmt.Errorf("unmarshalling raw variable: %w", err)
+	}
+
+	*v = Variable(raw)
+
+	return nil
+}
+
+var errInvalidVariableValue = errors.New("invalid variable value")
+
+// Convert parses a raw value according to the variable's type.
+func (v Variable) Convert(raw string) (interface{}, error) {
+	var (
+		val interface{}
+		err error
+	)
+
+	switch v.Type {
+	case "int":
+		val, err = strconv.Atoi(raw)
+	case "float":
+		val, err = strconv.ParseFloat(raw, 64)
+	case "bool":
+		val, err = strconv.ParseBool(raw)
+	default:
+		val = raw
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid %s: %w", raw, v.Type, errInvalidVariableValue)
+	}
+
+	return val, nil
+}
+
+// Zero returns the zero value of the variable's type.
+func (v Variable) Zero() interface{} {
+	switch v.Type {
+	case "int":
+		return 0
+	case "float":
+		return 0.0
+	case "bool":
+		return false
+	default:
+		return ""
+	}
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=error").Parse(text)
+}
+
+// templateFields returns the top-level field names referenced by the
+// template, e.g. 'threshold' for '{{ .threshold }}' or '{{ $.threshold }}'.
+func templateFields(tmpl *template.Template) []string {
+	fields := make(map[string]struct{})
+
+	if tmpl.Tree != nil {
+		collectFields(tmpl.Tree.Root, fields, true)
+	}
+
+	result := make([]string, 0, len(fields))
+
+	for f := range fields {
+		result = append(result, f)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// collectFields adds the top-level field names referenced below node
+// to fields. Fields are referenced either as '$.name' or, while dot
+// still refers to the template data, as '.name'. Within the body of
+// 'range' and 'with' dot refers to another value so that only fields
+// referenced through '$' are collected there.
+func collectFields(node parse.Node, fields map[string]struct{}, topLevelDot bool) { //nolint:cyclop
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+
+		for _, child := range n.Nodes {
+			collectFields(child, fields, topLevelDot)
+		}
+	case *parse.ActionNode:
+		collectFields(n.Pipe, fields, topLevelDot)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+
+		for _, cmd := range n.Cmds {
+			collectFields(cmd, fields, topLevelDot)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectFields(arg, fields, topLevelDot)
+		}
+	case *parse.FieldNode:
+		if topLevelDot {
+			fields[n.Ident[0]] = struct{}{}
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			fields[n.Ident[1]] = struct{}{}
+		}
+	case *parse.ChainNode:
+		collectFields(n.Node, fields, topLevelDot)
+	case *parse.IfNode:
+		collectBranchFields(&n.BranchNode, fields, topLevelDot, topLevelDot)
+	case *parse.RangeNode:
+		collectBranchFields(&n.BranchNode, fields, topLevelDot, false)
+	case *parse.WithNode:
+		collectBranchFields(&n.BranchNode, fields, topLevelDot, false)
+	}
+}
+
+// collectBranchFields collects the fields of a branch whose body is
+// evaluated with dot referring to the template data if bodyDot is set.
+// The pipeline and else branch are evaluated with the enclosing dot.
+func collectBranchFields(n *parse.BranchNode, fields map[string]struct{}, topLevelDot, bodyDot bool) {
+	collectFields(n.Pipe, fields, topLevelDot)
+	collectFields(n.List, fields, bodyDot)
+	collectFields(n.ElseList, fields, topLevelDot)
+}
+
+var (
+	errUndeclaredVariable = errors.New("undeclared template variable")
+	errReservedVariable   = errors.New("variable name is reserved")
+	errMissingVariable    = errors.New("missing required variable")
+	errUnknownVariable    = errors.New("unknown variable")
+)
+
+// Placeholders returns the names of all variables referenced by the
+// summary and description templates of the config.
+func (c *Config) Placeholders() ([]string, error) {
+	seen := make(map[string]struct{})
+
+	for name, text := range map[string]string{
+		"summary":     c.Summary,
+		"description": c.Description,
+	} {
+		tmpl, err := parseTemplate(name, text)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s template: %w", name, err)
+		}
+
+		for _, f := range templateFields(tmpl) {
+			seen[f] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(seen))
+
+	for f := range seen {
+		result = append(result, f)
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// UsesVariable returns true if the given variable is referenced
+// by any template of the config.
+func (c *Config) UsesVariable(name string) bool {
+	placeholders, err := c.Placeholders()
+	if err != nil {
+		return false
+	}
+
+	for _, p := range placeholders {
+		if p == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Config) validateTemplates() error {
+	for name := range c.Variables {
+		if isBuiltinVariable(name) {
+			return fmt.Errorf("%q: %w", name, errReservedVariable)
+		}
+	}
+
+	placeholders, err := c.Placeholders()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range placeholders {
+		if _, ok := c.Variables[p]; ok || isBuiltinVariable(p) {
+			continue
+		}
+
+		return fmt.Errorf("%q: %w", p, errUndeclaredVariable)
+	}
+
+	for name, v := range c.Variables {
+		if v.Default == "" {
+			continue
+		}
+
+		if _, err := v.Convert(v.Default); err != nil {
+			return fmt.Errorf("default value of variable %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// ResolveVariables converts the supplied raw variable values according
+// to the declared variable types, applying defaults for any optional
+// variables which were not supplied. An error is returned if a required
+// variable is missing or if a value is supplied for an undeclared variable.
+// Optional variables without a default which were not supplied resolve to
+// the zero value of their type.
+func (c *Config) ResolveVariables(vars map[string]string) (map[string]interface{}, error) {
+	for name := range vars {
+		if _, ok := c.Variables[name]; !ok {
+			return nil, fmt.Errorf("%q: %w", name, errUnknownVariable)
+		}
+	}
+
+	result := make(map[string]interface{}, len(c.Variables))
+
+	for name, v := range c.Variables {
+		raw, ok := vars[name]
+		if !ok {
+			if v.Required {
+				return nil, fmt.Errorf("%q: %w", name, errMissingVariable)
+			}
+
+			if v.Default == "" {
+				result[name] = v.Zero()
+
+				continue
+			}
+
+			raw = v.Default
+		}
+
+		val, err := v.Convert(raw)
+		if err != nil {
+			return nil, fmt.Errorf("variable %q: %w", name, err)
+		}
+
+		result[name] = val
+	}
+
+	return result, nil
+}
+
+// Render returns a copy of the config with its summary and description
+// templates executed using the supplied cluster context and variable
+// values. An error is returned if the variables cannot be resolved or
+// if the rendered config is no longer valid.
+func (c Config) Render(cc ClusterContext, vars map[string]string) (Config, error) {
+	resolved, err := c.ResolveVariables(vars)
+	if err != nil {
+		return Config{}, err
+	}
+
+	data := cc.values()
+
+	for name, val := range resolved {
+		data[name] = val
+	}
+
+	if c.Summary, err = execute("summary", c.Summary, data); err != nil {
+		return Config{}, err
+	}
+
+	if c.Description, err = execute("description", c.Description, data); err != nil {
+		return Config{}, err
+	}
+
+	if err := _validator.Struct(c); err != nil {
+		return Config{}, fmt.Errorf("validating rendered config: %w", err)
+	}
+
+	return c, nil
+}
+
+func execute(name, text string, data map[string]interface{}) (string, error) {
+	tmpl, err := parseTemplate(name, text)
+	if err != nil {
+		return "", fmt.Errorf("parsing %s template: %w", name, err)
+	}
+
+	var sb strings.Builder
+
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("rendering %s template: %w", name, err)
+	}
+
+	return sb.String(), nil
+}
+
+var errInvalidVariableAssignment = errors.New("variables must be of the form 'key=value'")
+
+// ParseVariables parses raw 'key=value' assignments into a map
+// suitable for Config.Render.
+func ParseVariables(raw []string) (map[string]string, error) {
+	result := make(map[string]string, len(raw))
+
+	for _, r := range raw {
+		key, val, ok := strings.Cut(r, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("%q: %w", r, errInvalidVariableAssignment)
+		}
+
+		result[strings.TrimSpace(key)] = val
+	}
+
+	return result, nil
+}
@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestTemplatedConfig(t *testing.T) {
+	t.Parallel()
+
+	testFS := fstest.MapFS{
+		"data/test-team/test-product.yaml": {
+			Data: []byte(templatedConfig()),
+		},
+	}
+
+	tree, err := loadNotifications(testFS)
+	require.NoError(t, err)
+
+	cfg, ok := tree.GetNotification("test-team", "test-product", "test-notification")
+	require.True(t, ok)
+
+	placeholders, err := cfg.Placeholders()
+	require.NoError(t, err)
+	assert.Equal(t, []string{VarAddonVersion, VarClusterName, "threshold"}, placeholders)
+	assert.True(t, cfg.UsesVariable(VarAddonVersion))
+	assert.False(t, cfg.UsesVariable(VarConsoleURL))
+
+	cc := ClusterContext{
+		AddonVersion: "1.2.3",
+		ClusterName:  "test-cluster",
+	}
+
+	rendered, err := cfg.Render(cc, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "StorageNearlyFull", rendered.Summary)
+	assert.Equal(t, "Storage on test-cluster (version 1.2.3) is above 80 percent", rendered.Description)
+
+	rendered, err = cfg.Render(cc, map[string]string{"threshold": "95"})
+	require.NoError(t, err)
+	assert.Equal(t, "Storage on test-cluster (version 1.2.3) is above 95 percent", rendered.Description)
+
+	_, err = cfg.Render(cc, map[string]string{"threshold": "high"})
+	assert.ErrorIs(t, err, errInvalidVariableValue)
+
+	_, err = cfg.Render(cc, map[string]string{"unknown": "value"})
+	assert.ErrorIs(t, err, errUnknownVariable)
+}
+
+func templatedConfig() string {
+	result := []string{
+		"---",
+		"test-notification:",
+		"  summary: StorageNearlyFull",
+		"  description: Storage on {{ .clusterName }} (version {{ .addonVersion }}) is above {{ .threshold }} percent",
+		"  severity: Warning",
+		"  variables:",
+		"    threshold:",
+		"      type: int",
+		"      default: \"80\"",
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func TestRenderRequiredVariable(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Description: "Volume {{ .volume }} must be resized",
+		Severity:    "Info",
+		Summary:     "ResizeRequired",
+		Variables: map[string]Variable{
+			"volume": {Type: "string", Required: true},
+		},
+	}
+
+	_, err := cfg.Render(ClusterContext{}, nil)
+	assert.ErrorIs(t, err, errMissingVariable)
+
+	rendered, err := cfg.Render(ClusterContext{}, map[string]string{"volume": "data"})
+	require.NoError(t, err)
+	assert.Equal(t, "Volume data must be resized", rendered.Description)
+}
+
+func TestRenderOptionalVariableWithoutDefault(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Description: "Retries: {{ .retries }}, ratio: {{ .ratio }}, urgent: {{ .urgent }}, note: '{{ .note }}' end",
+		Severity:    "Info",
+		Summary:     "Retrying",
+		Variables: map[string]Variable{
+			"retries": {Type: "int"},
+			"ratio":   {Type: "float"},
+			"urgent":  {Type: "bool"},
+			"note":    {Type: "string"},
+		},
+	}
+
+	rendered, err := cfg.Render(ClusterContext{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Retries: 0, ratio: 0, urgent: false, note: '' end", rendered.Description)
+
+	rendered, err = cfg.Render(ClusterContext{}, map[string]string{"retries": "3", "urgent": "true"})
+	require.NoError(t, err)
+	assert.Equal(t, "Retries: 3, ratio: 0, urgent: true, note: '' end", rendered.Description)
+}
+
+func TestTemplateFields(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Text     string
+		Expected []string
+	}{
+		"field": {
+			Text:     "{{ .threshold }}",
+			Expected: []string{"threshold"},
+		},
+		"root variable": {
+			Text:     "{{ $.threshold }}",
+			Expected: []string{"threshold"},
+		},
+		"local variable": {
+			Text:     "{{ $t := .threshold }}{{ $t }}",
+			Expected: []string{"threshold"},
+		},
+		"if": {
+			Text:     "{{ if .enabled }}{{ .threshold }}{{ else }}{{ .fallback }}{{ end }}",
+			Expected: []string{"enabled", "fallback", "threshold"},
+		},
+		"with": {
+			Text:     "{{ with .limits }}{{ .threshold }}{{ $.unit }}{{ else }}{{ .fallback }}{{ end }}",
+			Expected: []string{"fallback", "limits", "unit"},
+		},
+		"range": {
+			Text:     "{{ range .nodes }}{{ .name }} in {{ $.region }}{{ end }}",
+			Expected: []string{"nodes", "region"},
+		},
+		"nested in range": {
+			Text:     "{{ range .nodes }}{{ if .ready }}{{ $.clusterName }}{{ end }}{{ end }}",
+			Expected: []string{"clusterName", "nodes"},
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := parseTemplate(name, tc.Text)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.Expected, templateFields(tmpl))
+		})
+	}
+}
+
+func TestBadTemplatedConfigs(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Data []string
+	}{
+		"undeclared variable": {
+			Data: []string{
+				"  description: Threshold is {{ .threshold }}",
+			},
+		},
+		"reserved variable": {
+			Data: []string{
+				"  description: Cluster is {{ .clusterName }}",
+				"  variables:",
+				"    clusterName: {}",
+			},
+		},
+		"invalid variable type": {
+			Data: []string{
+				"  description: Threshold is {{ .threshold }}",
+				"  variables:",
+				"    threshold:",
+				"      type: duration",
+			},
+		},
+		"invalid default": {
+			Data: []string{
+				"  description: Threshold is {{ .threshold }}",
+				"  variables:",
+				"    threshold:",
+				"      type: int",
+				"      default: high",
+			},
+		},
+		"undeclared root variable": {
+			Data: []string{
+				"  description: Threshold is {{ $.threshold }}",
+			},
+		},
+		"invalid template": {
+			Data: []string{
+				"  description: Threshold is {{ .threshold",
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := append([]string{
+				"---",
+				"test-notification:",
+				"  summary: TestNotificationSummary",
+				"  severity: Error",
+			}, tc.Data...)
+
+			badFS := fstest.MapFS{
+				"data/test-team/test-product.yaml": {
+					Data: []byte(strings.Join(data, "\n")),
+				},
+			}
+
+			tree, err := loadNotifications(badFS)
+			assert.Error(t, err, tree)
+		})
+	}
+}
+
+func TestVariableInterfaces(t *testing.T) {
+	t.Parallel()
+
+	require.Implements(t, new(yaml.Unmarshaler), new(Variable))
+}
+
+func TestParseVariables(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Raw         []string
+		Expected    map[string]string
+		ShouldError bool
+	}{
+		"none": {
+			Expected: map[string]string{},
+		},
+		"multiple": {
+			Raw:      []string{"threshold=80", "message=a=b"},
+			Expected: map[string]string{"threshold": "80", "message": "a=b"},
+		},
+		"empty value": {
+			Raw:      []string{"message="},
+			Expected: map[string]string{"message": ""},
+		},
+		"missing separator": {
+			Raw:         []string{"threshold"},
+			ShouldError: true,
+		},
+		"missing key": {
+			Raw:         []string{"=80"},
+			ShouldError: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			vars, err := ParseVariables(tc.Raw)
+			if tc.ShouldError {
+				require.ErrorIs(t, err, errInvalidVariableAssignment)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, vars)
+		})
+	}
+}
@@ -5,6 +5,7 @@
 package ocm
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -61,6 +62,8 @@ func (a *Addon) ProvideRowData() map[string]interface{} {
 	return result
 }
 
+// WithVersion retrieves the current version of the add-on. The version
+// is served from and stored in the add-on's Cache if one is configured.
 func (a *Addon) WithVersion(ctx context.Context) (*Addon, error) {
 	version := a.addon.Version().ID()
 
@@ -72,15 +75,33 @@ func (a *Addon) WithVersion(ctx context.Context) (*Addon, error) {
 		Trace("requesting version information")
 	defer trace.Stop(nil)
 
-	ver, err := a.cfg.Conn.
+	var key cacheKey
+
+	if a.cfg.Cache != nil {
+		key = cacheKey{
+			URL:  a.cfg.Conn.URL(),
+			Path: fmt.Sprintf("/api/clusters_mgmt/v1/addons/%s/versions/%s", a.ID(), version),
+		}
+
+		if body, ok := a.cfg.Cache.get(key); ok {
+			if ver, err := cmv1.UnmarshalAddOnVersion(body); err == nil {
+				a.version = &AddonVersion{ver: ver}
+
+				return a, nil
+			}
+		}
+	}
+
+	request := a.cfg.Conn.
 		ClustersMgmt().
 		V1().
 		Addons().
 		Addon(a.ID()).
 		Versions().
 		Version(version).
-		Get().
-		SendContext(ctx)
+		Get()
+
+	ver, err := retry(ctx, a.cfg.Retry, trace, retryTransient, request.SendContext)
 	if err != nil {
 		return a, fmt.Errorf("requesting addon: %w", err)
 	}
@@ -89,12 +110,34 @@ func (a *Addon) WithVersion(ctx context.Context) (*Addon, error) {
 		ver: ver.Body(),
 	}
 
+	if a.cfg.Cache != nil {
+		a.cacheVersion(key)
+	}
+
 	return a, nil
 }
 
+func (a *Addon) cacheVersion(key cacheKey) {
+	var buf bytes.Buffer
+
+	if err := cmv1.MarshalAddOnVersion(a.version.ver, &buf); err != nil {
+		a.cfg.Logger.WithError(err).Debug("unable to encode add-on version for cache")
+
+		return
+	}
+
+	if err := a.cfg.Cache.put(key, buf.Bytes()); err != nil {
+		a.cfg.Logger.WithError(err).Debug("unable to cache add-on version")
+	}
+}
+
 type AddonConfig struct {
+	Cache  *Cache
 	Conn   *sdk.Connection
 	Logger log.Interface
+	// Paging configures the pager which requests the items.
+	Paging PagerConfig
+	Retry  *RetryPolicy
 }
 
 func (c *AddonConfig) Option(opts ...AddonOption) {
@@ -109,6 +152,10 @@ func (c *AddonConfig) Default() {
 			Handler: discard.New(),
 		}
 	}
+
+	if c.Retry == nil {
+		c.Retry = NewRetryPolicy()
+	}
 }
 
 type AddonOption interface {
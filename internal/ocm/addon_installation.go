@@ -46,9 +46,10 @@ type AddonInstallation struct {
 	cfg     AddonInstallationConfig
 }
 
-func (a *AddonInstallation) ID() string    { return a.cfg.Addon.ID() }
-func (a *AddonInstallation) Name() string  { return a.cfg.Addon.Name() }
-func (a *AddonInstallation) State() string { return string(a.install.State()) }
+func (a *AddonInstallation) ID() string        { return a.cfg.Addon.ID() }
+func (a *AddonInstallation) Name() string      { return a.cfg.Addon.Name() }
+func (a *AddonInstallation) State() string     { return string(a.install.State()) }
+func (a *AddonInstallation) VersionID() string { return a.install.AddonVersion().ID() }
 
 func (a *AddonInstallation) ProvideRowData() map[string]interface{} {
 	result := map[string]interface{}{
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"testing"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddonInstallationFilter(t *testing.T) {
+	t.Parallel()
+
+	install, err := cmv1.NewAddOnInstallation().
+		ID("test-addon").
+		AddonVersion(cmv1.NewAddOnVersion().ID("1.2.3")).
+		State(cmv1.AddOnInstallationStateFailed).
+		Build()
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		Filter   AddonInstallationFilter
+		Expected bool
+	}{
+		"empty filter": {
+			Expected: true,
+		},
+		"addon only": {
+			Filter:   AddonInstallationFilter{AddonID: "test-addon"},
+			Expected: true,
+		},
+		"addon, version and state": {
+			Filter: AddonInstallationFilter{
+				AddonID:   "test-addon",
+				VersionID: "1.2.3",
+				State:     "FAILED",
+			},
+			Expected: true,
+		},
+		"different addon": {
+			Filter: AddonInstallationFilter{AddonID: "other-addon"},
+		},
+		"different version": {
+			Filter: AddonInstallationFilter{AddonID: "test-addon", VersionID: "1.2.4"},
+		},
+		"different state": {
+			Filter: AddonInstallationFilter{AddonID: "test-addon", State: "ready"},
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.Expected, tc.Filter.matches(install))
+		})
+	}
+}
@@ -6,40 +6,79 @@ package ocm
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/apex/log"
+	"github.com/mt-sre/ocm-addons/internal/query"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 )
 
-const (
-	addonPageSize = 50
-)
+// RetrieveAddons initializes a Pager which will request addons from OCM.
+// The supplied options are applied to each Addon returned by the Pager. Pages are requested
+// according to the PagerConfig of a WithPaging option, served from and stored in the Cache
+// of a WithCache option if given and failed requests are retried according to the
+// RetryPolicy of a WithRetryPolicy option or the default policy.
+func RetrieveAddons(conn *sdk.Connection, logger log.Interface, opts ...AddonOption) (*AddonPager, error) {
+	var (
+		cfg     AddonConfig
+		request addonsListRequester = &addonsListRequest{
+			conn.ClustersMgmt().V1().Addons().List(),
+		}
+	)
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	request = &retryingAddonsListRequest{
+		addonsListRequester: request,
+		logger:              logger,
+		policy:              cfg.Retry,
+	}
+
+	if cfg.Cache != nil {
+		request = &cachedAddonsListRequest{
+			addonsListRequester: request,
+			cache:               cfg.Cache,
+			logger:              logger,
+			url:                 conn.URL(),
+		}
+	}
+
+	return newAddonPager(request, cfg.Paging, append([]AddonOption{
+		WithConnection{Connection: conn},
+		WithLogger{Logger: logger},
+	}, opts...)...), nil
+}
+
+func newAddonPager(request addonsListRequester, cfg PagerConfig, opts ...AddonOption) *AddonPager {
+	fetch := func(ctx context.Context, params pageParams) (pageResult[Addon], error) {
+		res, err := request.RequestPage(ctx, params)
+		if err != nil {
+			return pageResult[Addon]{}, err
+		}
+
+		items := res.Items().Slice()
+		addons := make([]Addon, 0, len(items))
 
-// RetrieveAddons initializes a Pager which will request addons from OCM with a fixed page size.
-func RetrieveAddons(conn *sdk.Connection, logger log.Interface) (*AddonPager, error) {
-	request := &addonsListRequest{
-		conn.ClustersMgmt().V1().Addons().List(),
+		for _, addon := range items {
+			addons = append(addons, NewAddon(addon, opts...))
+		}
+
+		return pageResult[Addon]{
+			Items: addons,
+			Size:  res.Size(),
+			Total: res.Total(),
+		}, nil
 	}
 
 	return &AddonPager{
-		conn:    conn,
-		index:   1,
-		logger:  logger,
-		request: request,
-	}, nil
+		Pager: newPager("addon", fetch, cfg),
+	}
 }
 
 // AddonPager retains state for paged addon requests and maintains a buffer
 // of the last page of objects.
 type AddonPager struct {
-	buffer    []Addon
-	finalPage bool
-	index     int
-	conn      *sdk.Connection
-	logger    log.Interface
-	request   addonsListRequester
+	*Pager[Addon]
 }
 
 // SearchByNameOrID filters the addons requested by a Pager for those
@@ -49,13 +88,10 @@ func (p *AddonPager) SearchByNameOrID(pattern string) *AddonPager {
 		return p
 	}
 
-	query := fmt.Sprintf(
-		"name like '%s' or id like '%s'",
-		pattern,
-		pattern,
-	)
-
-	return p.Search(query)
+	return p.Search(query.Or(
+		query.Like("name", pattern),
+		query.Like("id", pattern),
+	))
 }
 
 // FindByIDs uses the supplied addon IDs to filter the request to OCM
@@ -65,84 +101,13 @@ func (p *AddonPager) FindByIDs(ids ...string) *AddonPager {
 		return p
 	}
 
-	quotedIDs := make([]string, 0, len(ids))
-
-	for _, id := range ids {
-		quotedIDs = append(quotedIDs, fmt.Sprintf("'%s'", id))
-	}
-
-	query := fmt.Sprintf("id in (%s)", strings.Join(quotedIDs, ","))
-
-	return p.Search(query)
+	return p.Search(query.In("id", ids...))
 }
 
-// Search filters the addons requested by a generic query string.
-// See 'ocm-sdk-go' for more information on the SQL-like strings that
-// are accepted.
-func (p *AddonPager) Search(query string) *AddonPager {
+// Search filters the addons requested by a search expression.
+// If the AddonPager is already filtered both expressions must match.
+func (p *AddonPager) Search(expr query.Expr) *AddonPager {
 	return &AddonPager{
-		conn:    p.conn,
-		index:   1,
-		logger:  p.logger,
-		request: p.request.Search(query),
+		Pager: p.Pager.Search(expr),
 	}
 }
-
-// ForEach iterates over the addons requested by an Pager applying
-// the provided function. If the iteration will stop with the first
-// error returned by the provided function.
-func (p *AddonPager) ForEach(ctx context.Context, applyFunc func(*Addon) error) error {
-	for {
-		addons, hasMorePages, err := p.NextPage(ctx)
-		if err != nil {
-			return err
-		}
-
-		if !hasMorePages {
-			return nil
-		}
-
-		for i := range addons {
-			err = applyFunc(&addons[i])
-			if err != nil {
-				return err
-			}
-		}
-	}
-}
-
-// NextPage returns the next page of requested addons if there are any remaining.
-// If no addons remain the second return value will be 'false'.
-func (p *AddonPager) NextPage(ctx context.Context) ([]Addon, bool, error) {
-	if p.finalPage {
-		return nil, false, nil
-	}
-
-	if p.buffer == nil {
-		p.buffer = make([]Addon, addonPageSize)
-	}
-
-	p.buffer = p.buffer[:0]
-
-	res, err := p.request.RequestPage(ctx, p.index, addonPageSize)
-	if err != nil {
-		return nil, false, err
-	}
-
-	for _, addon := range res.Items().Slice() {
-		p.buffer = append(p.buffer,
-			NewAddon(addon,
-				WithConnection{Connection: p.conn},
-				WithLogger{Logger: p.logger},
-			),
-		)
-	}
-
-	if res.Size() < addonPageSize {
-		p.finalPage = true
-	}
-
-	p.index++
-
-	return p.buffer, true, nil
-}
@@ -46,7 +46,7 @@ func TestAddonPagerShortcircuit(t *testing.T) {
 
 	expectedIterations := 25
 
-	pager := setupAddonPager(addonPageSize - 1)
+	pager := setupAddonPager(DefaultPageSize - 1)
 
 	var actualIterations int
 
@@ -67,10 +67,10 @@ func TestAddonPagerShortcircuit(t *testing.T) {
 func setupAddonPager(totalItems int) *AddonPager {
 	response := &addonsListResponseMock{}
 
-	for i := totalItems; i > 0; i -= addonPageSize {
-		returnSize := addonPageSize
+	for i := totalItems; i > 0; i -= DefaultPageSize {
+		returnSize := DefaultPageSize
 
-		if i < addonPageSize {
+		if i < DefaultPageSize {
 			returnSize = i
 		}
 
@@ -84,7 +84,7 @@ func setupAddonPager(totalItems int) *AddonPager {
 			Once()
 	}
 
-	expectedPageRequests := int(math.Ceil(float64(totalItems) / float64(addonPageSize)))
+	expectedPageRequests := int(math.Ceil(float64(totalItems) / float64(DefaultPageSize)))
 
 	request := &addonsListRequestMock{}
 	request.
@@ -92,10 +92,7 @@ func setupAddonPager(totalItems int) *AddonPager {
 		Return(response, nil).
 		Times(expectedPageRequests)
 
-	return &AddonPager{
-		index:   1,
-		request: request,
-	}
+	return newAddonPager(request, PagerConfig{})
 }
 
 func addonList(size int) *cmv1.AddOnList {
@@ -116,13 +113,12 @@ func addonList(size int) *cmv1.AddOnList {
 
 type addonsListRequestMock struct {
 	mock.Mock
+	params []pageParams
 }
 
-func (a *addonsListRequestMock) Search(string) addonsListRequester {
-	return a
-}
+func (a *addonsListRequestMock) RequestPage(_ context.Context, params pageParams) (addonsListResponser, error) {
+	a.params = append(a.params, params)
 
-func (a *addonsListRequestMock) RequestPage(context.Context, int, int) (addonsListResponser, error) {
 	args := a.Called()
 
 	return args.Get(0).(*addonsListResponseMock), args.Error(1) //nolint:forcetypeassert
@@ -130,6 +126,7 @@ func (a *addonsListRequestMock) RequestPage(context.Context, int, int) (addonsLi
 
 type addonsListResponseMock struct {
 	mock.Mock
+	total int
 }
 
 func (a *addonsListResponseMock) Items() *cmv1.AddOnList {
@@ -143,3 +140,5 @@ func (a *addonsListResponseMock) Size() int {
 
 	return args.Int(0)
 }
+
+func (a *addonsListResponseMock) Total() int { return a.total }
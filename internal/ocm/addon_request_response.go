@@ -5,31 +5,41 @@
 package ocm
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"strconv"
 
+	"github.com/apex/log"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
 type addonsListRequester interface {
-	Search(string) addonsListRequester
-	RequestPage(context.Context, int, int) (addonsListResponser, error)
+	RequestPage(context.Context, pageParams) (addonsListResponser, error)
 }
 
 type addonsListRequest struct {
 	*cmv1.AddOnsListRequest
 }
 
-func (a *addonsListRequest) Search(query string) addonsListRequester {
-	a.AddOnsListRequest = a.AddOnsListRequest.Search(query)
+func (a *addonsListRequest) RequestPage(ctx context.Context, params pageParams) (addonsListResponser, error) {
+	// Pagers filtered by different queries share the request so
+	// each page is requested from a copy.
+	base := *a.AddOnsListRequest
 
-	return a
-}
+	request := base.
+		Size(params.Size).
+		Page(params.Page)
+
+	if params.Search != "" {
+		request = request.Search(params.Search)
+	}
 
-func (a *addonsListRequest) RequestPage(ctx context.Context, page, size int) (addonsListResponser, error) {
-	response, err := a.AddOnsListRequest.
-		Size(size).
-		Page(page).
-		SendContext(ctx)
+	if params.Order != "" {
+		request = request.Order(params.Order)
+	}
+
+	response, err := request.SendContext(ctx)
 
 	return &addonsListResponse{
 		AddOnsListResponse: response,
@@ -39,6 +49,7 @@ func (a *addonsListRequest) RequestPage(ctx context.Context, page, size int) (ad
 type addonsListResponser interface {
 	Items() *cmv1.AddOnList
 	Size() int
+	Total() int
 }
 
 type addonsListResponse struct {
@@ -48,3 +59,96 @@ type addonsListResponse struct {
 func (a *addonsListResponse) Items() *cmv1.AddOnList {
 	return a.AddOnsListResponse.Items()
 }
+
+// cachedAddonsListRequest serves pages of add-ons from a Cache
+// falling back to the wrapped request for missing entries.
+type cachedAddonsListRequest struct {
+	addonsListRequester
+	cache  *Cache
+	logger log.Interface
+	url    string
+}
+
+func (a *cachedAddonsListRequest) RequestPage(ctx context.Context, params pageParams) (addonsListResponser, error) {
+	key := cacheKey{
+		URL:  a.url,
+		Path: "/api/clusters_mgmt/v1/addons",
+		Query: []string{
+			params.Search,
+			strconv.Itoa(params.Page),
+			strconv.Itoa(params.Size),
+			params.Order,
+		},
+	}
+
+	if body, ok := a.cache.get(key); ok {
+		if response, err := newCachedAddonsListResponse(body); err == nil {
+			return response, nil
+		}
+	}
+
+	response, err := a.addonsListRequester.RequestPage(ctx, params)
+	if err != nil {
+		return response, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := cmv1.MarshalAddOnList(response.Items().Slice(), &buf); err != nil {
+		a.logger.WithError(err).Debug("unable to encode add-ons for cache")
+
+		return response, nil
+	}
+
+	if err := a.cache.put(key, buf.Bytes()); err != nil {
+		a.logger.WithError(err).Debug("unable to cache add-ons")
+	}
+
+	return response, nil
+}
+
+func newCachedAddonsListResponse(body []byte) (*cachedAddonsListResponse, error) {
+	items, err := cmv1.UnmarshalAddOnList(body)
+	if err != nil {
+		return nil, fmt.Errorf("decoding cached add-ons: %w", err)
+	}
+
+	builders := make([]*cmv1.AddOnBuilder, 0, len(items))
+
+	for _, item := range items {
+		builders = append(builders, cmv1.NewAddOn().Copy(item))
+	}
+
+	list, err := cmv1.NewAddOnList().Items(builders...).Build()
+	if err != nil {
+		return nil, fmt.Errorf("building cached add-ons: %w", err)
+	}
+
+	return &cachedAddonsListResponse{items: list}, nil
+}
+
+type cachedAddonsListResponse struct {
+	items *cmv1.AddOnList
+}
+
+func (a *cachedAddonsListResponse) Items() *cmv1.AddOnList { return a.items }
+func (a *cachedAddonsListResponse) Size() int              { return a.items.Len() }
+
+// Total returns zero as the number of matching add-ons is not cached.
+func (a *cachedAddonsListResponse) Total() int { return 0 }
+
+// retryingAddonsListRequest retries pages which fail with
+// transient errors according to a RetryPolicy.
+type retryingAddonsListRequest struct {
+	addonsListRequester
+	logger log.Interface
+	policy *RetryPolicy
+}
+
+func (a *retryingAddonsListRequest) RequestPage(ctx context.Context, params pageParams) (addonsListResponser, error) {
+	a.logger.WithFields(params.fields()).Debug("requesting page of add-ons")
+
+	return retry(ctx, a.policy, a.logger, retryTransient, func(ctx context.Context) (addonsListResponser, error) {
+		return a.addonsListRequester.RequestPage(ctx, params)
+	})
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	amv1 "github.com/openshift-online/ocm-sdk-go/addonsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// AddonInstallationStatus details the status of a single add-on
+// installation including any status conditions reported by the
+// add-on as well as the fulfilment of the add-on's requirements
+// for the cluster it is installed on.
+type AddonInstallationStatus struct {
+	install      *cmv1.AddOnInstallation
+	Conditions   []AddonStatusCondition
+	Requirements []AddonRequirement
+	SubOperators []AddonSubOperator
+}
+
+func (s *AddonInstallationStatus) State() string {
+	return string(s.install.State())
+}
+
+func (s *AddonInstallationStatus) StateDescription() string {
+	return s.install.StateDescription()
+}
+
+func (s *AddonInstallationStatus) VersionID() string {
+	return s.install.AddonVersion().ID()
+}
+
+// UnfulfilledRequirements returns the requirements which are not
+// currently fulfilled by the cluster.
+func (s *AddonInstallationStatus) UnfulfilledRequirements() []AddonRequirement {
+	var result []AddonRequirement
+
+	for _, req := range s.Requirements {
+		if req.req.Status().Fulfilled() {
+			continue
+		}
+
+		result = append(result, req)
+	}
+
+	return result
+}
+
+// AddonStatusCondition wraps an 'ocm-sdk-go' AddonStatusCondition object.
+type AddonStatusCondition struct {
+	cond *amv1.AddonStatusCondition
+}
+
+func (c *AddonStatusCondition) ProvideRowData() map[string]interface{} {
+	if c == nil {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"Message": c.cond.Message(),
+		"Reason":  c.cond.Reason(),
+		"Status":  c.cond.StatusValue(),
+		"Type":    c.cond.StatusType(),
+	}
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm_test
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddonStatusConditionInterfaces(t *testing.T) {
+	require.Implements(t, new(cli.RowDataProvider), new(ocm.AddonStatusCondition))
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// NewCache returns a Cache storing entries in the given directory
+// which remain valid for the given TTL.
+func NewCache(dir string, ttl time.Duration, opts ...CacheOption) *Cache {
+	cache := &Cache{
+		dir: dir,
+		ttl: ttl,
+	}
+
+	cache.cfg.Option(opts...)
+	cache.cfg.Default()
+
+	return cache
+}
+
+// Cache stores responses to OCM requests for rarely changing data,
+// such as the add-on catalog, on disk. Entries are keyed by the OCM
+// URL and query of the request. A nil Cache stores nothing.
+type Cache struct {
+	cfg CacheConfig
+	dir string
+	ttl time.Duration
+}
+
+// Dir returns the directory holding cache entries.
+func (c *Cache) Dir() string { return c.dir }
+
+// Clear removes all entries from the cache directory and returns
+// the number of entries removed.
+func (c *Cache) Clear() (int, error) {
+	files, err := filepath.Glob(filepath.Join(c.dir, "*"+_cacheEntryExt))
+	if err != nil {
+		return 0, fmt.Errorf("listing cache entries: %w", err)
+	}
+
+	for i, file := range files {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return i, fmt.Errorf("removing cache entry: %w", err)
+		}
+	}
+
+	return len(files), nil
+}
+
+// get returns the body stored for key if the cache holds an entry
+// which has not expired and refreshing is not requested.
+func (c *Cache) get(key cacheKey) ([]byte, bool) {
+	if c == nil || c.cfg.Refresh {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cacheEntry
+
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	if entry.Key != key.String() || c.cfg.Now().Sub(entry.Created) > c.ttl {
+		return nil, false
+	}
+
+	return entry.Body, true
+}
+
+// put stores body for key replacing any existing entry.
+func (c *Cache) put(key cacheKey, body []byte) error {
+	if c == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(cacheEntry{
+		Key:     key.String(),
+		Created: c.cfg.Now(),
+		Body:    body,
+	})
+	if err != nil {
+		return fmt.Errorf("encoding cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(c.dir, "entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating cache entry: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("writing cache entry: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("storing cache entry: %w", err)
+	}
+
+	return nil
+}
+
+const _cacheEntryExt = ".json"
+
+func (c *Cache) path(key cacheKey) string {
+	sum := sha256.Sum256([]byte(key.String()))
+
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+_cacheEntryExt)
+}
+
+// cacheKey identifies the response to a request made to the
+// OCM API at URL for the given resource path and query.
+type cacheKey struct {
+	URL   string
+	Path  string
+	Query []string
+}
+
+func (k cacheKey) String() string {
+	return strings.Join(append([]string{k.URL, k.Path}, k.Query...), "\n")
+}
+
+type cacheEntry struct {
+	Key     string          `json:"key"`
+	Created time.Time       `json:"created"`
+	Body    json.RawMessage `json:"body"`
+}
+
+type CacheConfig struct {
+	Now     func() time.Time
+	Refresh bool
+}
+
+func (c *CacheConfig) Option(opts ...CacheOption) {
+	for _, opt := range opts {
+		opt.ConfigureCache(c)
+	}
+}
+
+func (c *CacheConfig) Default() {
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+}
+
+type CacheOption interface {
+	ConfigureCache(*CacheConfig)
+}
+
+// WithRefresh ignores existing cache entries while still
+// storing fresh responses.
+type WithRefresh bool
+
+func (wr WithRefresh) ConfigureCache(c *CacheConfig) {
+	c.Refresh = bool(wr)
+}
+
+// WithNow replaces the source of the current time.
+type WithNow func() time.Time
+
+func (wn WithNow) ConfigureCache(c *CacheConfig) {
+	c.Now = wn
+}
+
+// WithCache stores responses for add-on data in the given cache.
+type WithCache struct{ Cache *Cache }
+
+func (wc WithCache) ConfigureAddon(c *AddonConfig) {
+	c.Cache = wc.Cache
+}
+
+func (wc WithCache) ConfigureCluster(c *ClusterConfig) {
+	c.Cache = wc.Cache
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/discard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	dir := t.TempDir()
+	cache := NewCache(dir, time.Hour, WithNow(clock))
+
+	key := cacheKey{URL: "https://api.openshift.com", Path: "/addons", Query: []string{"id = 'a'"}}
+	other := cacheKey{URL: "https://api.stage.openshift.com", Path: "/addons", Query: []string{"id = 'a'"}}
+
+	_, ok := cache.get(key)
+	assert.False(t, ok, "should miss before anything is stored")
+
+	require.NoError(t, cache.put(key, []byte(`{"id":"a"}`)))
+
+	body, ok := cache.get(key)
+	require.True(t, ok, "should hit after storing")
+	assert.JSONEq(t, `{"id":"a"}`, string(body))
+
+	_, ok = cache.get(other)
+	assert.False(t, ok, "should be keyed by URL")
+
+	refreshing := NewCache(dir, time.Hour, WithNow(clock), WithRefresh(true))
+
+	_, ok = refreshing.get(key)
+	assert.False(t, ok, "should miss when refreshing")
+
+	expired := NewCache(dir, time.Hour, WithNow(func() time.Time { return now.Add(2 * time.Hour) }))
+
+	_, ok = expired.get(key)
+	assert.False(t, ok, "should miss once the TTL has passed")
+
+	removed, err := cache.Clear()
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	_, ok = cache.get(key)
+	assert.False(t, ok, "should miss after clearing")
+
+	var disabled *Cache
+
+	require.NoError(t, disabled.put(key, []byte(`{}`)))
+
+	_, ok = disabled.get(key)
+	assert.False(t, ok, "nil cache should never hit")
+}
+
+func TestCachedAddonsListRequest(t *testing.T) {
+	t.Parallel()
+
+	response := &addonsListResponseMock{}
+	response.On("Items").Return(addonList(3)).Twice()
+
+	inner := &addonsListRequestMock{}
+	inner.On("RequestPage").Return(response, nil).Once()
+
+	cache := NewCache(t.TempDir(), time.Hour)
+
+	request := &cachedAddonsListRequest{
+		addonsListRequester: inner,
+		cache:               cache,
+		logger:              &log.Logger{Handler: discard.New()},
+		url:                 "https://api.openshift.com",
+	}
+
+	ctx := context.Background()
+
+	first, err := request.RequestPage(ctx, pageParams{Page: 1, Size: DefaultPageSize, Search: "id like 'test%'"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, first.Items().Len())
+
+	second, err := request.RequestPage(ctx, pageParams{Page: 1, Size: DefaultPageSize, Search: "id like 'test%'"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, second.Size())
+	assert.Equal(t, "test-addon-2", second.Items().Get(2).Name())
+
+	inner.AssertExpectations(t)
+	response.AssertExpectations(t)
+}
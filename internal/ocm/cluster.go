@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"sort"
 	"strings"
 	"time"
@@ -17,9 +18,12 @@ import (
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
-	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+
+	"github.com/mt-sre/ocm-addons/internal/query"
 )
 
+// ocmTimeFormat formats times in search queries which OCM compares
+// against timestamps in UTC.
 const ocmTimeFormat = "2006-01-02 15:04:05"
 
 var errInstallationNotFound = errors.New("installation not found")
@@ -37,12 +41,14 @@ func NewCluster(cluster *cmv1.Cluster, opts ...ClusterOption) Cluster {
 
 // Cluster wraps for 'ocm-sdk-go' Cluster objects.
 type Cluster struct {
-	cfg                ClusterConfig
-	cluster            *cmv1.Cluster
-	subscription       *Subscription
-	AddonInstallations AddonInstallations
+	cfg                   ClusterConfig
+	cluster               *cmv1.Cluster
+	subscription          *Subscription
+	AddonInstallations    AddonInstallations
+	LimitedSupportReasons LimitedSupportReasons
 }
 
+func (c *Cluster) ConsoleURL() string { return c.cluster.Console().URL() }
 func (c *Cluster) ExternalID() string { return c.cluster.ExternalID() }
 func (c *Cluster) ID() string         { return c.cluster.ID() }
 func (c *Cluster) Name() string       { return c.cluster.Name() }
@@ -70,6 +76,7 @@ func (c *Cluster) ProvideRowData() map[string]interface{} {
 		"Health State":                     c.cluster.HealthState(),
 		"ID":                               c.cluster.ID(),
 		"Installed Addons":                 c.installedAddons(),
+		"Limited Support Reasons":          c.LimitedSupportReasons.Summaries(),
 		"Load Balancer Qutoa":              c.cluster.LoadBalancerQuota(),
 		"Managed":                          c.cluster.Managed(),
 		"Multi AZ":                         c.cluster.MultiAZ(),
@@ -107,14 +114,15 @@ func (c *Cluster) WithSubscription(ctx context.Context) (*Cluster, error) {
 		Trace("requesting subscription information")
 	defer trace.Stop(nil)
 
-	sub, err := c.cfg.Conn.
+	request := c.cfg.Conn.
 		AccountsMgmt().
 		V1().
 		Subscriptions().
 		Subscription(c.cluster.Subscription().ID()).
 		Get().
-		Parameter("fetchAccounts", true).
-		SendContext(ctx)
+		Parameter("fetchAccounts", true)
+
+	sub, err := retry(ctx, c.cfg.Retry, trace, retryTransient, request.SendContext)
 	if err != nil {
 		return c, err
 	}
@@ -145,7 +153,10 @@ func (c *Cluster) WithAddonInstallations(ctx context.Context) (*Cluster, error)
 		ids = append(ids, install.ID())
 	}
 
-	addons, err := RetrieveAddons(c.cfg.Conn, c.cfg.Logger)
+	addons, err := RetrieveAddons(c.cfg.Conn, c.cfg.Logger,
+		WithCache{Cache: c.cfg.Cache},
+		WithRetryPolicy{Policy: c.cfg.Retry},
+	)
 	if err != nil {
 		return c, err
 	}
@@ -172,6 +183,182 @@ func (c *Cluster) WithAddonInstallations(ctx context.Context) (*Cluster, error)
 	return c, nil
 }
 
+// WithLimitedSupportReasons attempts to retrieve the limited support
+// reasons which are currently active for the cluster. Any failure to
+// retrieve data will return an error.
+func (c *Cluster) WithLimitedSupportReasons(ctx context.Context) (*Cluster, error) {
+	trace := c.cfg.Logger.
+		WithFields(log.Fields{
+			"cluster": c.cluster.ID(),
+		}).
+		Trace("requesting limited support reasons")
+	defer trace.Stop(nil)
+
+	request := c.cfg.Conn.
+		ClustersMgmt().
+		V1().
+		Clusters().
+		Cluster(c.cluster.ID()).
+		LimitedSupportReasons().
+		List()
+
+	res, err := retry(ctx, c.cfg.Retry, trace, retryTransient, request.SendContext)
+	if err != nil {
+		return c, fmt.Errorf("requesting limited support reasons: %w", err)
+	}
+
+	c.LimitedSupportReasons = make(LimitedSupportReasons, 0, res.Items().Len())
+
+	res.Items().Each(func(reason *cmv1.LimitedSupportReason) bool {
+		c.LimitedSupportReasons = append(c.LimitedSupportReasons, LimitedSupportReason{
+			reason: reason,
+		})
+
+		return true
+	})
+
+	return c, nil
+}
+
+// AddLimitedSupportReason places the cluster into limited support for the
+// configured reason and returns the reason created by OCM.
+func (c *Cluster) AddLimitedSupportReason(ctx context.Context, opts ...LimitedSupportReasonOption) (LimitedSupportReason, error) {
+	trace := c.cfg.Logger.
+		WithFields(log.Fields{
+			"cluster": c.cluster.ID(),
+		}).Trace("adding limited support reason")
+	defer trace.Stop(nil)
+
+	reason, err := NewLimitedSupportReason(opts...)
+	if err != nil {
+		return LimitedSupportReason{}, fmt.Errorf("generating limited support reason: %w", err)
+	}
+
+	request := c.cfg.Conn.
+		ClustersMgmt().
+		V1().
+		Clusters().
+		Cluster(c.cluster.ID()).
+		LimitedSupportReasons().
+		Add().
+		Body(reason)
+
+	res, err := retry(ctx, c.cfg.Retry, trace, retryUnsent, request.SendContext)
+	if err != nil {
+		return LimitedSupportReason{}, fmt.Errorf("adding limited support reason: %w", err)
+	}
+
+	return LimitedSupportReason{
+		reason: res.Body(),
+	}, nil
+}
+
+// RemoveLimitedSupportReason removes the limited support reason with the
+// given id from the cluster.
+func (c *Cluster) RemoveLimitedSupportReason(ctx context.Context, id string) error {
+	trace := c.cfg.Logger.
+		WithFields(log.Fields{
+			"cluster": c.cluster.ID(),
+			"reason":  id,
+		}).Trace("removing limited support reason")
+	defer trace.Stop(nil)
+
+	request := c.cfg.Conn.
+		ClustersMgmt().
+		V1().
+		Clusters().
+		Cluster(c.cluster.ID()).
+		LimitedSupportReasons().
+		LimitedSupportReason(id).
+		Delete()
+
+	_, err := retry(ctx, c.cfg.Retry, trace, retryUnsent, request.SendContext)
+	if err != nil {
+		return fmt.Errorf("removing limited support reason %q: %w", id, err)
+	}
+
+	return nil
+}
+
+// ErrAddonNotInstalled is returned by AddonInstallationStatus if the
+// add-on is not installed on the cluster.
+var ErrAddonNotInstalled = errors.New("addon not installed")
+
+// AddonInstallationStatus retrieves the detailed status of the given
+// add-on's installation on the cluster. The requirements are evaluated
+// by OCM against this particular cluster. Status conditions are only
+// available for add-ons which report them. If the add-on is not
+// installed an error wrapping ErrAddonNotInstalled is returned.
+func (c *Cluster) AddonInstallationStatus(ctx context.Context, addonID string) (AddonInstallationStatus, error) {
+	trace := c.cfg.Logger.
+		WithFields(log.Fields{
+			"cluster": c.cluster.ID(),
+			"addon":   addonID,
+		}).
+		Trace("requesting addon installation status")
+	defer trace.Stop(nil)
+
+	cluster := c.cfg.Conn.ClustersMgmt().V1().Clusters().Cluster(c.cluster.ID())
+
+	installRequest := cluster.
+		Addons().
+		Addoninstallation(addonID).
+		Get()
+
+	install, err := retry(ctx, c.cfg.Retry, trace, retryTransient, installRequest.SendContext)
+	if install != nil && install.Status() == http.StatusNotFound {
+		return AddonInstallationStatus{}, fmt.Errorf("addon %q: %w", addonID, ErrAddonNotInstalled)
+	} else if err != nil {
+		return AddonInstallationStatus{}, fmt.Errorf("requesting addon installation: %w", err)
+	}
+
+	inquiryRequest := cluster.
+		AddonInquiries().
+		AddonInquiry(addonID).
+		Get()
+
+	inquiry, err := retry(ctx, c.cfg.Retry, trace, retryTransient, inquiryRequest.SendContext)
+	if err != nil {
+		return AddonInstallationStatus{}, fmt.Errorf("requesting addon inquiry: %w", err)
+	}
+
+	status := AddonInstallationStatus{
+		install: install.Body(),
+	}
+
+	for _, req := range inquiry.Body().Requirements() {
+		status.Requirements = append(status.Requirements, AddonRequirement{req: req})
+	}
+
+	for _, sub := range inquiry.Body().SubOperators() {
+		status.SubOperators = append(status.SubOperators, AddonSubOperator{sub: sub})
+	}
+
+	statusRequest := c.cfg.Conn.
+		AddonsMgmt().
+		V1().
+		Clusters().
+		Cluster(c.cluster.ID()).
+		Status().
+		Addon(addonID).
+		Get()
+
+	conditions, err := retry(ctx, c.cfg.Retry, trace, retryTransient, statusRequest.SendContext)
+	if conditions != nil && conditions.Status() == http.StatusNotFound {
+		trace.Debug("no status reported for addon")
+
+		return status, nil
+	} else if err != nil {
+		return AddonInstallationStatus{}, fmt.Errorf("requesting addon status: %w", err)
+	}
+
+	for _, cond := range conditions.Body().StatusConditions() {
+		status.Conditions = append(status.Conditions, AddonStatusCondition{cond: cond})
+	}
+
+	return status, nil
+}
+
 func (c *Cluster) retrieveInstallations(ctx context.Context) ([]*cmv1.AddOnInstallation, error) {
 	trace := c.cfg.Logger.
 		WithFields(log.Fields{
@@ -180,14 +367,15 @@ func (c *Cluster) retrieveInstallations(ctx context.Context) ([]*cmv1.AddOnInsta
 		Trace("requesting addon installations")
 	defer trace.Stop(nil)
 
-	res, err := c.cfg.Conn.
+	request := c.cfg.Conn.
 		ClustersMgmt().
 		V1().
 		Clusters().
 		Cluster(c.cluster.ID()).
 		Addons().
-		List().
-		SendContext(ctx)
+		List()
+
+	res, err := retry(ctx, c.cfg.Retry, trace, retryTransient, request.SendContext)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +385,68 @@ func (c *Cluster) retrieveInstallations(ctx context.Context) ([]*cmv1.AddOnInsta
 	return res.Items().Slice(), nil
 }
 
+// AddonInstallationFilter selects add-on installations by add-on ID
+// and optionally by installed version and state. Empty fields match
+// any value.
+type AddonInstallationFilter struct {
+	AddonID   string
+	VersionID string
+	State     string
+}
+
+func (f AddonInstallationFilter) matches(install *cmv1.AddOnInstallation) bool {
+	if f.AddonID != "" && install.ID() != f.AddonID {
+		return false
+	}
+
+	if f.VersionID != "" && install.AddonVersion().ID() != f.VersionID {
+		return false
+	}
+
+	if f.State != "" && !strings.EqualFold(string(install.State()), f.State) {
+		return false
+	}
+
+	return true
+}
+
+// HasAddonInstallation returns true if any add-on installation of the
+// cluster matches the given filter. Unlike WithAddonInstallations no
+// add-on metadata is requested.
+func (c *Cluster) HasAddonInstallation(ctx context.Context, filter AddonInstallationFilter) (bool, error) {
+	installs, err := c.retrieveInstallations(ctx)
+	if err != nil {
+		return false, fmt.Errorf("retrieving addon installations: %w", err)
+	}
+
+	for _, install := range installs {
+		if filter.matches(install) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// InstalledAddonVersions returns the installed version of every add-on
+// installed on the cluster keyed by add-on ID. Unlike
+// WithAddonInstallations no add-on metadata is requested and any
+// failure to retrieve the installations is returned.
+func (c *Cluster) InstalledAddonVersions(ctx context.Context) (map[string]string, error) {
+	installs, err := c.retrieveInstallations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving addon installations: %w", err)
+	}
+
+	versions := make(map[string]string, len(installs))
+
+	for _, install := range installs {
+		versions[install.ID()] = install.AddonVersion().ID()
+	}
+
+	return versions, nil
+}
+
 func findInstallationByID(installs []*cmv1.AddOnInstallation, addonID string) (*cmv1.AddOnInstallation, error) {
 	for _, addonInstallation := range installs {
 		if addonInstallation.ID() != addonID {
@@ -237,7 +487,9 @@ func (c *Cluster) ProductID() string {
 	return fmt.Sprintf("%s,%s", c.cluster.Product().ID(), ccsDisplayValue)
 }
 
-func (c *Cluster) PostLog(ctx context.Context, opts ...LogEntryOption) error {
+// PostLog creates a service log entry for the cluster and returns
+// the entry created by OCM which includes its ID.
+func (c *Cluster) PostLog(ctx context.Context, opts ...LogEntryOption) (LogEntry, error) {
 	trace := c.cfg.Logger.
 		WithFields(log.Fields{
 			"cluster": c.cluster.ID(),
@@ -246,7 +498,7 @@ func (c *Cluster) PostLog(ctx context.Context, opts ...LogEntryOption) error {
 
 	ent, err := NewLogEntry(c, opts...)
 	if err != nil {
-		return fmt.Errorf("generating log entry: %w", err)
+		return LogEntry{}, fmt.Errorf("generating log entry: %w", err)
 	}
 
 	trace.WithFields(log.Fields{
@@ -254,60 +506,166 @@ func (c *Cluster) PostLog(ctx context.Context, opts ...LogEntryOption) error {
 		"entrySummary":  ent.Entry.Summary(),
 	}).Debug("generated entry")
 
-	res, err := c.cfg.Conn.
+	request := c.cfg.Conn.
 		ServiceLogs().
 		V1().
 		ClusterLogs().
 		Add().
-		Body(ent.Entry).
-		SendContext(ctx)
+		Body(ent.Entry)
+
+	res, err := retry(ctx, c.cfg.Retry, trace, retryUnsent, request.SendContext)
 	if err != nil {
-		return fmt.Errorf("posting log entry: %w", err)
+		return LogEntry{}, fmt.Errorf("posting log entry: %w", err)
 	}
 
 	if res.Error() != nil {
-		return fmt.Errorf("posting log entry failed with status %d: %w", res.Status(), res.Error())
+		return LogEntry{}, fmt.Errorf("posting log entry failed with status %d: %w", res.Status(), res.Error())
 	}
 
-	return nil
+	return LogEntry{Entry: res.Body()}, nil
 }
 
-func (c *Cluster) GetLogs(ctx context.Context, opts GetLogsOptions) ([]LogEntry, error) {
-	query := opts.Query()
+var errLogEntryNotOfCluster = errors.New("log entry does not belong to cluster")
 
+// GetLog retrieves the service log entry with the given ID. An error
+// is returned if the entry was not created for the cluster.
+func (c *Cluster) GetLog(ctx context.Context, logID string) (LogEntry, error) {
 	trace := c.cfg.Logger.
 		WithFields(log.Fields{
 			"cluster": c.cluster.ID(),
-			"query":   query,
-		}).Trace("retrieving cluster log entries")
+			"logID":   logID,
+		}).Trace("retrieving log entry")
 	defer trace.Stop(nil)
 
-	res, err := c.cfg.Conn.
+	request := c.cfg.Conn.
 		ServiceLogs().
 		V1().
-		Clusters().
-		Cluster(c.cluster.ExternalID()).
 		ClusterLogs().
-		List().
-		Search(query).
-		SendContext(ctx)
+		LogEntry(logID).
+		Get()
+
+	res, err := retry(ctx, c.cfg.Retry, trace, retryTransient, request.SendContext)
 	if err != nil {
-		return nil, err
+		return LogEntry{}, fmt.Errorf("retrieving log entry: %w", err)
 	}
 
-	entries := NewLogEntrySorter(res.Items().Len(), opts.sorter)
+	entry := res.Body()
 
-	res.Items().Each(func(entry *slv1.LogEntry) bool {
-		entries.Append(LogEntry{Entry: entry})
+	if entry.ClusterUUID() != c.cluster.ExternalID() && entry.ClusterID() != c.cluster.ID() {
+		return LogEntry{}, fmt.Errorf("%q: %w %q", logID, errLogEntryNotOfCluster, c.cluster.ID())
+	}
 
-		return true
-	})
+	return LogEntry{Entry: entry}, nil
+}
 
-	sort.Sort(entries)
+// DeleteLog deletes the service log entry with the given ID.
+func (c *Cluster) DeleteLog(ctx context.Context, logID string) error {
+	trace := c.cfg.Logger.
+		WithFields(log.Fields{
+			"cluster": c.cluster.ID(),
+			"logID":   logID,
+		}).Trace("deleting log entry")
+	defer trace.Stop(nil)
+
+	request := c.cfg.Conn.
+		ServiceLogs().
+		V1().
+		ClusterLogs().
+		LogEntry(logID).
+		Delete()
+
+	res, err := retry(ctx, c.cfg.Retry, trace, retryUnsent, request.SendContext)
+	if err != nil {
+		return fmt.Errorf("deleting log entry: %w", err)
+	}
+
+	if res.Error() != nil {
+		return fmt.Errorf("deleting log entry failed with status %d: %w", res.Status(), res.Error())
+	}
+
+	return nil
+}
+
+// GetLogs retrieves the log entries of the cluster matching the options.
+// Pages are requested according to the PagerConfig of a GetLogsPaged option
+// until all matching entries, or at most its limit, have been retrieved.
+func (c *Cluster) GetLogs(ctx context.Context, opts GetLogsOptions) ([]LogEntry, error) {
+	search := opts.Query()
+
+	trace := c.cfg.Logger.
+		WithFields(log.Fields{
+			"cluster": c.cluster.ID(),
+			"query":   search.String(),
+		}).Trace("retrieving cluster log entries")
+	defer trace.Stop(nil)
+
+	paging := opts.paging
+	if paging.Order == "" {
+		paging.Order = opts.order.Clause("timestamp")
+	}
+
+	entries := NewLogEntrySorter(0, opts.sorter)
+
+	if err := c.logPager(trace, paging).Search(search).ForEach(ctx, func(entry *LogEntry) error {
+		entries.Append(*entry)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if opts.sorter != nil {
+		sort.Sort(entries)
+	}
 
 	return entries.Entries(), nil
 }
 
+// logPager returns a Pager requesting the log entries of the cluster.
+func (c *Cluster) logPager(logger log.Interface, cfg PagerConfig) *Pager[LogEntry] {
+	fetch := func(ctx context.Context, params pageParams) (pageResult[LogEntry], error) {
+		request := c.cfg.Conn.
+			ServiceLogs().
+			V1().
+			Clusters().
+			Cluster(c.cluster.ExternalID()).
+			ClusterLogs().
+			List().
+			Page(params.Page).
+			Size(params.Size)
+
+		if params.Search != "" {
+			request.Search(params.Search)
+		}
+
+		if params.Order != "" {
+			request.Order(params.Order)
+		}
+
+		logger.WithFields(params.fields()).Debug("requesting page of log entries")
+
+		res, err := retry(ctx, c.cfg.Retry, logger, retryTransient, request.SendContext)
+		if err != nil {
+			return pageResult[LogEntry]{}, err
+		}
+
+		items := res.Items().Slice()
+		entries := make([]LogEntry, 0, len(items))
+
+		for _, entry := range items {
+			entries = append(entries, LogEntry{Entry: entry})
+		}
+
+		return pageResult[LogEntry]{
+			Items: entries,
+			Size:  res.Size(),
+			Total: res.Total(),
+		}, nil
+	}
+
+	return newPager("log entry", fetch, cfg)
+}
+
 func NewGetLogsOptions(opts ...GetLogsOption) GetLogsOptions {
 	var glo GetLogsOptions
 
@@ -319,35 +677,71 @@ func NewGetLogsOptions(opts ...GetLogsOption) GetLogsOptions {
 }
 
 type GetLogsOptions struct {
-	pattern string
-	lvl     LogLevel
-	sorter  LogEntrySortFunc
-	before  time.Time
-	after   time.Time
+	pattern      string
+	serviceNames []string
+	summary      string
+	lvls         []LogLevel
+	sorter       LogEntrySortFunc
+	order        Order
+	paging       PagerConfig
+	before       time.Time
+	after        time.Time
 }
 
-func (g GetLogsOptions) Query() string {
-	var predicates []string
+// With returns a copy of the options with the supplied options applied.
+func (g GetLogsOptions) With(opts ...GetLogsOption) GetLogsOptions {
+	g.lvls = append([]LogLevel(nil), g.lvls...)
+	g.serviceNames = append([]string(nil), g.serviceNames...)
+
+	for _, opt := range opts {
+		opt(&g)
+	}
+
+	return g
+}
+
+// Query returns the search expression selecting the log entries
+// matching the options.
+func (g GetLogsOptions) Query() query.Expr {
+	var predicates []query.Expr
 
 	if g.pattern != "" {
-		predicates = append(predicates, fmt.Sprintf("description like '%s'", g.pattern))
+		predicates = append(predicates, query.Like("description", g.pattern))
+	}
+
+	switch len(g.serviceNames) {
+	case 0:
+	case 1:
+		predicates = append(predicates, query.Equal("service_name", g.serviceNames[0]))
+	default:
+		predicates = append(predicates, query.In("service_name", g.serviceNames...))
 	}
 
-	if g.lvl != LogLevelNone {
-		predicates = append(predicates, fmt.Sprintf("severity = '%s'", g.lvl))
+	if g.summary != "" {
+		predicates = append(predicates, query.Equal("summary", g.summary))
+	}
+
+	if len(g.lvls) > 0 {
+		lvls := make([]string, 0, len(g.lvls))
+
+		for _, lvl := range g.lvls {
+			lvls = append(lvls, string(lvl))
+		}
+
+		predicates = append(predicates, query.In("severity", lvls...))
 	}
 
 	epoch := time.Time{}
 
 	if g.after.After(epoch) {
-		predicates = append(predicates, fmt.Sprintf("timestamp >= '%s'", g.after.Format(ocmTimeFormat)))
+		predicates = append(predicates, query.GreaterOrEqual("timestamp", g.after.UTC().Format(ocmTimeFormat)))
 	}
 
 	if g.before.After(epoch) {
-		predicates = append(predicates, fmt.Sprintf("timestamp <= '%s'", g.before.Format(ocmTimeFormat)))
+		predicates = append(predicates, query.LessOrEqual("timestamp", g.before.UTC().Format(ocmTimeFormat)))
 	}
 
-	return strings.Join(predicates, " and ")
+	return query.And(predicates...)
 }
 
 type GetLogsOption func(*GetLogsOptions)
@@ -358,18 +752,66 @@ func GetLogsMatchingPattern(p string) GetLogsOption {
 	}
 }
 
-func GetLogsWithLevel(l LogLevel) GetLogsOption {
+// GetLogsWithServiceName limits the retrieved logs to those
+// created by any of the named services.
+func GetLogsWithServiceName(names ...string) GetLogsOption {
+	return func(g *GetLogsOptions) {
+		g.serviceNames = append(g.serviceNames, names...)
+	}
+}
+
+// GetLogsWithSummary limits the retrieved logs to those
+// with exactly the supplied summary.
+func GetLogsWithSummary(summary string) GetLogsOption {
 	return func(g *GetLogsOptions) {
-		g.lvl = l
+		g.summary = summary
 	}
 }
 
+// GetLogsWithLevel limits the retrieved logs to those matching any of
+// the supplied levels. 'LogLevelNone' values are ignored.
+func GetLogsWithLevel(lvls ...LogLevel) GetLogsOption {
+	return func(g *GetLogsOptions) {
+		for _, l := range lvls {
+			if l == LogLevelNone {
+				continue
+			}
+
+			g.lvls = append(g.lvls, l)
+		}
+	}
+}
+
+// GetLogsWithMinLevel limits the retrieved logs to those which are
+// at least as severe as the supplied level.
+func GetLogsWithMinLevel(l LogLevel) GetLogsOption {
+	return GetLogsWithLevel(LogLevelsAtLeast(l)...)
+}
+
 func GetLogsSorted(s LogEntrySortFunc) GetLogsOption {
 	return func(g *GetLogsOptions) {
 		g.sorter = s
 	}
 }
 
+// GetLogsByTime sorts the retrieved logs by time in the given order
+// and requests them from OCM in the same order so that a limit
+// retains the earliest or latest entries respectively.
+func GetLogsByTime(ord Order) GetLogsOption {
+	return func(g *GetLogsOptions) {
+		g.sorter = LogEntryByTime(ord)
+		g.order = ord
+	}
+}
+
+// GetLogsPaged configures the page size, limit and order in which
+// the logs are requested from OCM.
+func GetLogsPaged(p WithPaging) GetLogsOption {
+	return func(g *GetLogsOptions) {
+		g.paging = PagerConfig(p)
+	}
+}
+
 func GetLogsBefore(t time.Time) GetLogsOption {
 	return func(g *GetLogsOptions) {
 		g.before = t
@@ -383,8 +825,14 @@ func GetLogsAfter(t time.Time) GetLogsOption {
 }
 
 type ClusterConfig struct {
-	Conn   *sdk.Connection
-	Logger log.Interface
+	Cache *Cache
+	Conn  *sdk.Connection
+	// IncludeUnmanaged requests clusters which are not managed by OCM.
+	IncludeUnmanaged bool
+	Logger           log.Interface
+	// Paging configures the pager which requests the items.
+	Paging PagerConfig
+	Retry  *RetryPolicy
 }
 
 func (c *ClusterConfig) Option(opts ...ClusterOption) {
@@ -399,6 +847,10 @@ func (c *ClusterConfig) Default() {
 			Handler: discard.New(),
 		}
 	}
+
+	if c.Retry == nil {
+		c.Retry = NewRetryPolicy()
+	}
 }
 
 type ClusterOption interface {
@@ -6,39 +6,71 @@ package ocm
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/apex/log"
+	"github.com/mt-sre/ocm-addons/internal/query"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 )
 
-const (
-	clusterPageSize = 50
-)
+// RetrieveClusters initializes a ClusterPager which will request clusters from OCM.
+// Only clusters managed by OCM are requested unless a WithIncludeUnmanaged option is
+// given. The supplied options are applied to each Cluster returned by the ClusterPager.
+// Pages are requested according to the PagerConfig of a WithPaging option and failed
+// requests are retried according to the RetryPolicy of a WithRetryPolicy option or the
+// default policy.
+func RetrieveClusters(conn *sdk.Connection, logger log.Interface, opts ...ClusterOption) (*ClusterPager, error) {
+	var cfg ClusterConfig
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	list := conn.ClustersMgmt().V1().Clusters().List()
+	if !cfg.IncludeUnmanaged {
+		list = list.Parameter("managed", true)
+	}
 
-// RetrieveClusters initializes a ClusterPager which will request clusters from OCM with a fixed page size.
-func RetrieveClusters(conn *sdk.Connection, logger log.Interface) (*ClusterPager, error) {
-	request := &clustersListRequest{
-		conn.ClustersMgmt().V1().Clusters().List().Parameter("managed", true),
+	request := &retryingClustersListRequest{
+		clustersListRequester: &clustersListRequest{list},
+		logger:                logger,
+		policy:                cfg.Retry,
+	}
+
+	return newClusterPager(request, cfg.Paging, append([]ClusterOption{
+		WithConnection{Connection: conn},
+		WithLogger{Logger: logger},
+	}, opts...)...), nil
+}
+
+func newClusterPager(request clustersListRequester, cfg PagerConfig, opts ...ClusterOption) *ClusterPager {
+	fetch := func(ctx context.Context, params pageParams) (pageResult[Cluster], error) {
+		res, err := request.RequestPage(ctx, params)
+		if err != nil {
+			return pageResult[Cluster]{}, err
+		}
+
+		items := res.Items().Slice()
+		clusters := make([]Cluster, 0, len(items))
+
+		for _, cluster := range items {
+			clusters = append(clusters, NewCluster(cluster, opts...))
+		}
+
+		return pageResult[Cluster]{
+			Items: clusters,
+			Size:  res.Size(),
+			Total: res.Total(),
+		}, nil
 	}
 
 	return &ClusterPager{
-		conn:    conn,
-		index:   1,
-		logger:  logger,
-		request: request,
-	}, nil
+		Pager: newPager("cluster", fetch, cfg),
+	}
 }
 
 // ClusterPager retains state for paged cluster requests and maintains a buffer
 // of the last page of objects.
 type ClusterPager struct {
-	buffer    []Cluster
-	conn      *sdk.Connection
-	finalPage bool
-	index     int
-	logger    log.Interface
-	request   clustersListRequester
+	*Pager[Cluster]
 }
 
 // SearchByNameOrID filters the clusters requested by an ClusterPager for those
@@ -48,81 +80,60 @@ func (p *ClusterPager) SearchByNameOrID(pattern string) *ClusterPager {
 		return p
 	}
 
-	query := fmt.Sprintf(
-		"name like '%s' or id = '%s' or external_id = '%s'",
-		pattern,
-		pattern,
-		pattern,
-	)
-
-	return p.Search(query)
+	return p.Search(query.Or(
+		query.Like("name", pattern),
+		query.Equal("id", pattern),
+		query.Equal("external_id", pattern),
+	))
 }
 
-// Search filters the clusters requested by a generic query string.
-// See 'ocm-sdk-go' for more information on the SQL-like strings that
-// are accepted.
-func (p *ClusterPager) Search(query string) *ClusterPager {
-	return &ClusterPager{
-		conn:    p.conn,
-		logger:  p.logger,
-		index:   1,
-		request: p.request.Search(query),
+// FindByIdentifiers filters the clusters requested by a ClusterPager for
+// those whose 'id', 'external_id' or 'name' exactly matches any of the
+// supplied identifiers.
+func (p *ClusterPager) FindByIdentifiers(identifiers ...string) *ClusterPager {
+	if len(identifiers) == 0 {
+		return p
 	}
-}
 
-// ForEach iterates over the clusters requested by an ClusterPager applying
-// the provided function. If the iteration will stop with the first
-// error returned by the provided function.
-func (p *ClusterPager) ForEach(ctx context.Context, applyFunc func(*Cluster) error) error {
-	for {
-		clusters, hasMorePages, err := p.NextPage(ctx)
-		if err != nil {
-			return err
-		}
-
-		if !hasMorePages {
-			return nil
-		}
-
-		for i := range clusters {
-			err = applyFunc(&clusters[i])
-			if err != nil {
-				return err
-			}
-		}
-	}
+	return p.Search(query.Or(
+		query.In("id", identifiers...),
+		query.In("external_id", identifiers...),
+		query.In("name", identifiers...),
+	))
 }
 
-// NextPage returns the next page of requested clusters if there are any remaining.
-// If no clusters remain the second return value will be 'false'.
-func (p *ClusterPager) NextPage(ctx context.Context) ([]Cluster, bool, error) {
-	if p.finalPage {
-		return nil, false, nil
-	}
-
-	if p.buffer == nil {
-		p.buffer = make([]Cluster, clusterPageSize)
+// FindByOrganization filters the clusters requested by a ClusterPager
+// for those owned by the organization with the given ID.
+func (p *ClusterPager) FindByOrganization(orgID string) *ClusterPager {
+	if orgID == "" {
+		return p
 	}
 
-	p.buffer = p.buffer[:0]
+	return p.Search(query.Equal("organization.id", orgID))
+}
 
-	res, err := p.request.RequestPage(ctx, p.index, clusterPageSize)
-	if err != nil {
-		return nil, false, err
+// FindByProduct filters the clusters requested by a ClusterPager
+// for those of the given product (e.g. 'osd' or 'rosa').
+func (p *ClusterPager) FindByProduct(productID string) *ClusterPager {
+	if productID == "" {
+		return p
 	}
 
-	for _, cluster := range res.Items().Slice() {
-		p.buffer = append(p.buffer, NewCluster(cluster,
-			WithConnection{Connection: p.conn},
-			WithLogger{Logger: p.logger},
-		))
-	}
+	return p.Search(query.Equal("product.id", productID))
+}
 
-	if res.Size() < clusterPageSize {
-		p.finalPage = true
+// Search filters the clusters requested by a search expression.
+// If the ClusterPager is already filtered both expressions must match.
+func (p *ClusterPager) Search(expr query.Expr) *ClusterPager {
+	return &ClusterPager{
+		Pager: p.Pager.Search(expr),
 	}
+}
 
-	p.index++
+// WithIncludeUnmanaged requests clusters which are not managed by OCM,
+// e.g. registered OCP clusters, in addition to managed clusters.
+type WithIncludeUnmanaged bool
 
-	return p.buffer, true, nil
+func (wi WithIncludeUnmanaged) ConfigureCluster(c *ClusterConfig) {
+	c.IncludeUnmanaged = bool(wi)
 }
@@ -14,6 +14,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
 )
 
 func TestClusterPagerIteration(t *testing.T) {
@@ -46,7 +47,7 @@ func TestClusterPagerShortcircuit(t *testing.T) {
 
 	expectedIterations := 25
 
-	pager := setupClusterPager(clusterPageSize - 1)
+	pager := setupClusterPager(DefaultPageSize - 1)
 
 	var actualIterations int
 
@@ -64,13 +65,119 @@ func TestClusterPagerShortcircuit(t *testing.T) {
 	assert.Equal(expectedIterations, actualIterations, "should only iterate until short circuit is reached")
 }
 
+func TestClusterPagerContinueOnError(t *testing.T) {
+	t.Parallel()
+
+	pager := setupClusterPager(DefaultPageSize + 10)
+
+	var actualIterations int
+
+	err := pager.ForEach(context.Background(), func(cluster *Cluster) error {
+		actualIterations++
+
+		switch cluster.Name() {
+		case "test-cluster-3", "test-cluster-7":
+			return errClusterShortCircuit
+		}
+
+		return nil
+	}, WithContinueOnError(true))
+
+	require.ErrorIs(t, err, errClusterShortCircuit)
+	require.Equal(t, DefaultPageSize+10, actualIterations, "should iterate over every cluster")
+
+	var itemErrs []*ItemError
+
+	for _, e := range multierr.Errors(err) {
+		var itemErr *ItemError
+
+		require.ErrorAs(t, e, &itemErr)
+
+		itemErrs = append(itemErrs, itemErr)
+	}
+
+	require.Len(t, itemErrs, 4, "should record one error per failing cluster on each page")
+	require.Equal(t, "cluster", itemErrs[0].Kind)
+	require.Equal(t, "test-cluster-3", itemErrs[0].Name)
+}
+
+func TestClusterPagerProgress(t *testing.T) {
+	t.Parallel()
+
+	pager := setupClusterPager(DefaultPageSize + 10)
+
+	var progress progressRecorder
+
+	err := pager.ForEach(context.Background(), func(cluster *Cluster) error {
+		if cluster.Name() == "test-cluster-3" {
+			return errClusterShortCircuit
+		}
+
+		return nil
+	}, WithContinueOnError(true), WithProgress{Progress: &progress})
+
+	require.ErrorIs(t, err, errClusterShortCircuit)
+	require.Equal(t, []int{1, 2}, progress.pages)
+	require.Equal(t, DefaultPageSize+10, progress.total)
+	require.Equal(t, DefaultPageSize+10, progress.done)
+	require.Equal(t, 2, progress.failed, "should report the failing cluster on each page")
+}
+
+type progressRecorder struct {
+	pages  []int
+	total  int
+	done   int
+	failed int
+}
+
+func (p *progressRecorder) PageFetched(page, total int) {
+	p.pages = append(p.pages, page)
+	p.total = total
+}
+
+func (p *progressRecorder) ItemDone(err error) {
+	p.done++
+
+	if err != nil {
+		p.failed++
+	}
+}
+
+func TestClusterPagerSearch(t *testing.T) {
+	t.Parallel()
+
+	pager := newClusterPager(&clustersListRequestMock{}, PagerConfig{})
+
+	require.True(t, pager.FindByOrganization("").FindByProduct("").Query().IsZero())
+
+	filtered := pager.
+		SearchByNameOrID("my-cluster").
+		FindByOrganization("org-id").
+		FindByProduct("rosa")
+
+	require.Equal(t,
+		"(name like 'my-cluster' or id = 'my-cluster' or external_id = 'my-cluster') "+
+			"and organization.id = 'org-id' and product.id = 'rosa'",
+		filtered.Query().String(),
+	)
+	require.Equal(t,
+		"id in ('a', 'b') or external_id in ('a', 'b') or name in ('a', 'b')",
+		pager.FindByIdentifiers("a", "b").Query().String(),
+	)
+	require.Equal(t,
+		"name like 'it''s%' or id = 'it''s%' or external_id = 'it''s%'",
+		pager.SearchByNameOrID("it's%").Query().String(),
+		"should escape quotes in the pattern",
+	)
+}
+
 func setupClusterPager(totalItems int) *ClusterPager {
-	response := &clustersListResponseMock{}
+	response := &clustersListResponseMock{total: totalItems}
 
-	for i := totalItems; i > 0; i -= clusterPageSize {
-		returnSize := clusterPageSize
+	for i := totalItems; i > 0; i -= DefaultPageSize {
+		returnSize := DefaultPageSize
 
-		if i < clusterPageSize {
+		if i < DefaultPageSize {
 			returnSize = i
 		}
 
@@ -84,7 +191,7 @@ func setupClusterPager(totalItems int) *ClusterPager {
 			Once()
 	}
 
-	expectedPageRequests := int(math.Ceil(float64(totalItems) / float64(clusterPageSize)))
+	expectedPageRequests := int(math.Ceil(float64(totalItems) / float64(DefaultPageSize)))
 
 	request := &clustersListRequestMock{}
 	request.
@@ -92,10 +199,7 @@ func setupClusterPager(totalItems int) *ClusterPager {
 		Return(response, nil).
 		Times(expectedPageRequests)
 
-	return &ClusterPager{
-		index:   1,
-		request: request,
-	}
+	return newClusterPager(request, PagerConfig{})
 }
 
 func clusterList(size int) *cmv1.ClusterList {
@@ -116,15 +220,14 @@ func clusterList(size int) *cmv1.ClusterList {
 
 type clustersListRequestMock struct {
 	mock.Mock
+	params []pageParams
 }
 
 var _ clustersListRequester = (*clustersListRequest)(nil)
 
-func (a *clustersListRequestMock) Search(string) clustersListRequester {
-	return a
-}
+func (a *clustersListRequestMock) RequestPage(_ context.Context, params pageParams) (clustersListResponser, error) {
+	a.params = append(a.params, params)
 
-func (a *clustersListRequestMock) RequestPage(context.Context, int, int) (clustersListResponser, error) {
 	args := a.Called()
 
 	return args.Get(0).(*clustersListResponseMock), args.Error(1) //nolint:forcetypeassert
@@ -134,6 +237,7 @@ var _ clustersListResponser = (*clustersListResponseMock)(nil)
 
 type clustersListResponseMock struct {
 	mock.Mock
+	total int
 }
 
 func (a *clustersListResponseMock) Items() *cmv1.ClusterList {
@@ -147,3 +251,5 @@ func (a *clustersListResponseMock) Size() int {
 
 	return args.Int(0)
 }
+
+func (a *clustersListResponseMock) Total() int { return a.total }
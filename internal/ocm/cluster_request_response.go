@@ -7,12 +7,12 @@ package ocm
 import (
 	"context"
 
+	"github.com/apex/log"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
 type clustersListRequester interface {
-	Search(string) clustersListRequester
-	RequestPage(context.Context, int, int) (clustersListResponser, error)
+	RequestPage(context.Context, pageParams) (clustersListResponser, error)
 }
 
 type clustersListRequest struct {
@@ -21,17 +21,24 @@ type clustersListRequest struct {
 
 var _ clustersListRequester = (*clustersListRequest)(nil)
 
-func (c *clustersListRequest) Search(query string) clustersListRequester {
-	c.ClustersListRequest = c.ClustersListRequest.Search(query)
+func (c *clustersListRequest) RequestPage(ctx context.Context, params pageParams) (clustersListResponser, error) {
+	// Pagers filtered by different queries share the request so
+	// each page is requested from a copy.
+	base := *c.ClustersListRequest
 
-	return c
-}
+	request := base.
+		Size(params.Size).
+		Page(params.Page)
+
+	if params.Search != "" {
+		request = request.Search(params.Search)
+	}
 
-func (c *clustersListRequest) RequestPage(ctx context.Context, page, size int) (clustersListResponser, error) {
-	response, err := c.ClustersListRequest.
-		Size(size).
-		Page(page).
-		SendContext(ctx)
+	if params.Order != "" {
+		request = request.Order(params.Order)
+	}
+
+	response, err := request.SendContext(ctx)
 
 	return &clustersListResponse{
 		ClustersListResponse: response,
@@ -41,6 +48,7 @@ func (c *clustersListRequest) RequestPage(ctx context.Context, page, size int) (
 type clustersListResponser interface {
 	Items() *cmv1.ClusterList
 	Size() int
+	Total() int
 }
 
 var _ clustersListResponser = (*clustersListResponse)(nil)
@@ -52,3 +60,19 @@ type clustersListResponse struct {
 func (a *clustersListResponse) Items() *cmv1.ClusterList {
 	return a.ClustersListResponse.Items()
 }
+
+// retryingClustersListRequest retries pages which fail with
+// transient errors according to a RetryPolicy.
+type retryingClustersListRequest struct {
+	clustersListRequester
+	logger log.Interface
+	policy *RetryPolicy
+}
+
+func (c *retryingClustersListRequest) RequestPage(ctx context.Context, params pageParams) (clustersListResponser, error) {
+	c.logger.WithFields(params.fields()).Debug("requesting page of clusters")
+
+	return retry(ctx, c.policy, c.logger, retryTransient, func(ctx context.Context) (clustersListResponser, error) {
+		return c.clustersListRequester.RequestPage(ctx, params)
+	})
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/multierr"
+)
+
+// ItemError records the failure of the function applied by a pager's
+// ForEach to a single cluster or add-on.
+type ItemError struct {
+	// Kind is either "cluster" or "addon".
+	Kind string
+	ID   string
+	Name string
+	Err  error
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("%s %q: %v", e.Kind, e.ID, e.Err)
+}
+
+func (e *ItemError) Unwrap() error { return e.Err }
+
+func (e *ItemError) ProvideRowData() map[string]interface{} {
+	return map[string]interface{}{
+		"Kind":  e.Kind,
+		"ID":    e.ID,
+		"Name":  e.Name,
+		"Error": e.Err.Error(),
+	}
+}
+
+// applyEach applies applyFunc to each item in turn. Unless continuing
+// on error, iteration stops with the first error returned by applyFunc.
+// Otherwise each error is recorded as an ItemError and the errors of all
+// items are returned combined. The returned flag is false if iteration
+// must not continue with further items.
+func applyEach[T any](
+	ctx context.Context,
+	cfg ForEachConfig,
+	items []T,
+	itemErr func(*T, error) *ItemError,
+	applyFunc func(*T) error,
+) (bool, error) {
+	var errs error
+
+	for i := range items {
+		err := applyFunc(&items[i])
+
+		cfg.Progress.ItemDone(err)
+
+		if err == nil {
+			continue
+		}
+
+		if !cfg.ContinueOnError {
+			return false, err
+		}
+
+		multierr.AppendInto(&errs, itemErr(&items[i], err))
+
+		if ctx.Err() != nil {
+			return false, errs
+		}
+	}
+
+	return true, errs
+}
+
+type ForEachConfig struct {
+	ContinueOnError bool
+	Progress        Progress
+}
+
+func (c *ForEachConfig) Option(opts ...ForEachOption) {
+	for _, opt := range opts {
+		opt.ConfigureForEach(c)
+	}
+}
+
+func (c *ForEachConfig) Default() {
+	if c.Progress == nil {
+		c.Progress = noProgress{}
+	}
+}
+
+type ForEachOption interface {
+	ConfigureForEach(*ForEachConfig)
+}
+
+// WithContinueOnError continues iterating after the applied function
+// fails for an item and returns the errors of all items combined.
+type WithContinueOnError bool
+
+func (wc WithContinueOnError) ConfigureForEach(c *ForEachConfig) {
+	c.ContinueOnError = bool(wc)
+}
+
+// Progress is notified by a pager's ForEach as pages are fetched
+// and items are processed.
+type Progress interface {
+	// PageFetched is called after fetching each page with the
+	// 1-based number of the page and the total number of items
+	// matching the request as reported by OCM.
+	PageFetched(page, total int)
+	// ItemDone is called after applying the function to an item
+	// with the error it returned.
+	ItemDone(err error)
+}
+
+type noProgress struct{}
+
+func (noProgress) PageFetched(int, int) {}
+func (noProgress) ItemDone(error)       {}
+
+// WithProgress reports the progress of iteration to the given Progress.
+type WithProgress struct{ Progress Progress }
+
+func (wp WithProgress) ConfigureForEach(c *ForEachConfig) {
+	c.Progress = wp.Progress
+}
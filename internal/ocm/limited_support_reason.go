@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// LimitedSupportReasons wraps a slice of LimitedSupportReason objects.
+type LimitedSupportReasons []LimitedSupportReason
+
+// Summaries returns a comma-separated list of the reason summaries.
+func (r LimitedSupportReasons) Summaries() string {
+	summaries := make([]string, 0, len(r))
+
+	for _, reason := range r {
+		summaries = append(summaries, reason.reason.Summary())
+	}
+
+	return strings.Join(summaries, ",")
+}
+
+// LimitedSupportReason wraps an 'ocm-sdk-go' LimitedSupportReason object.
+type LimitedSupportReason struct {
+	reason *cmv1.LimitedSupportReason
+}
+
+func (r *LimitedSupportReason) ID() string      { return r.reason.ID() }
+func (r *LimitedSupportReason) Summary() string { return r.reason.Summary() }
+
+func (r *LimitedSupportReason) ProvideRowData() map[string]interface{} {
+	return map[string]interface{}{
+		"Creation Timestamp": r.reason.CreationTimestamp(),
+		"Details":            r.reason.Details(),
+		"Detection Type":     r.reason.DetectionType(),
+		"ID":                 r.reason.ID(),
+		"Summary":            r.reason.Summary(),
+		"Template ID":        r.reason.Template().ID(),
+	}
+}
+
+var errIncompleteLimitedSupportReason = errors.New("either a template or both summary and details are required")
+
+func NewLimitedSupportReason(opts ...LimitedSupportReasonOption) (*cmv1.LimitedSupportReason, error) {
+	var cfg LimitedSupportReasonConfig
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	builder := cmv1.NewLimitedSupportReason().
+		DetectionType(cmv1.DetectionTypeManual)
+
+	switch {
+	case cfg.TemplateID != "":
+		builder = builder.Template(
+			cmv1.NewLimitedSupportReasonTemplate().ID(cfg.TemplateID),
+		)
+	case cfg.Summary != "" && cfg.Details != "":
+		builder = builder.
+			Summary(cfg.Summary).
+			Details(cfg.Details)
+	default:
+		return nil, errIncompleteLimitedSupportReason
+	}
+
+	reason, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("building limited support reason: %w", err)
+	}
+
+	return reason, nil
+}
+
+type LimitedSupportReasonOption func(*LimitedSupportReasonConfig)
+
+func LimitedSupportReasonDetails(details string) LimitedSupportReasonOption {
+	return func(c *LimitedSupportReasonConfig) {
+		c.Details = details
+	}
+}
+
+func LimitedSupportReasonSummary(sum string) LimitedSupportReasonOption {
+	return func(c *LimitedSupportReasonConfig) {
+		c.Summary = sum
+	}
+}
+
+func LimitedSupportReasonTemplateID(id string) LimitedSupportReasonOption {
+	return func(c *LimitedSupportReasonConfig) {
+		c.TemplateID = id
+	}
+}
+
+type LimitedSupportReasonConfig struct {
+	Details    string
+	Summary    string
+	TemplateID string
+}
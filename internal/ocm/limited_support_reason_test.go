@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm_test
+
+import (
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitedSupportReasonInterfaces(t *testing.T) {
+	require.Implements(t, new(cli.RowDataProvider), new(ocm.LimitedSupportReason))
+}
+
+func TestNewLimitedSupportReason(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Options         []ocm.LimitedSupportReasonOption
+		ExpectedSummary string
+		ExpectedTmpl    string
+		Error           bool
+	}{
+		"template": {
+			Options: []ocm.LimitedSupportReasonOption{
+				ocm.LimitedSupportReasonTemplateID("test-template"),
+			},
+			ExpectedTmpl: "test-template",
+		},
+		"summary and details": {
+			Options: []ocm.LimitedSupportReasonOption{
+				ocm.LimitedSupportReasonSummary("Test Summary"),
+				ocm.LimitedSupportReasonDetails("Test details"),
+			},
+			ExpectedSummary: "Test Summary",
+		},
+		"summary only": {
+			Options: []ocm.LimitedSupportReasonOption{
+				ocm.LimitedSupportReasonSummary("Test Summary"),
+			},
+			Error: true,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, err := ocm.NewLimitedSupportReason(tc.Options...)
+			if tc.Error {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedSummary, reason.Summary())
+			require.Equal(t, tc.ExpectedTmpl, reason.Template().ID())
+			require.Equal(t, "manual", string(reason.DetectionType()))
+		})
+	}
+}
@@ -5,6 +5,7 @@
 package ocm
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -44,17 +45,42 @@ func (l *LogEntry) ProvideRowData() map[string]interface{} {
 	severity := strings.ToUpper(string(l.Entry.Severity()))
 
 	return map[string]interface{}{
-		"timestamp":    l.Entry.Timestamp(),
-		"cluster_uuid": l.Entry.ClusterUUID(),
-		"description":  l.Entry.Description(),
-		"id":           l.Entry.ID(),
-		"service_name": l.Entry.ServiceName(),
-		"severity":     severity,
-		"summary":      l.Entry.Summary(),
-		"username":     l.Entry.Username(),
+		"timestamp":     l.Entry.Timestamp(),
+		"cluster_uuid":  l.Entry.ClusterUUID(),
+		"description":   l.Entry.Description(),
+		"id":            l.Entry.ID(),
+		"internal_only": l.Entry.InternalOnly(),
+		"service_name":  l.Entry.ServiceName(),
+		"severity":      severity,
+		"summary":       l.Entry.Summary(),
+		"username":      l.Entry.Username(),
 	}
 }
 
+// MarshalJSON encodes the wrapped entry using the OCM API
+// representation so that no fields are lost.
+func (l *LogEntry) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := slv1.MarshalLogEntry(l.Entry, &buf); err != nil {
+		return nil, fmt.Errorf("marshalling log entry: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes an entry previously encoded with MarshalJSON.
+func (l *LogEntry) UnmarshalJSON(data []byte) error {
+	entry, err := slv1.UnmarshalLogEntry(data)
+	if err != nil {
+		return fmt.Errorf("unmarshalling log entry: %w", err)
+	}
+
+	l.Entry = entry
+
+	return nil
+}
+
 type LogLevel string
 
 const (
@@ -66,6 +92,33 @@ const (
 	LogLevelFatal   = "Fatal"
 )
 
+// LogLevels returns all known log levels ordered from least
+// to most severe.
+func LogLevels() []LogLevel {
+	return []LogLevel{
+		LogLevelDebug,
+		LogLevelInfo,
+		LogLevelWarning,
+		LogLevelError,
+		LogLevelFatal,
+	}
+}
+
+// LogLevelsAtLeast returns all known log levels which are at
+// least as severe as the supplied minimum. If the minimum is
+// not a known level an empty slice is returned.
+func LogLevelsAtLeast(minLvl LogLevel) []LogLevel {
+	lvls := LogLevels()
+
+	for i, lvl := range lvls {
+		if lvl == minLvl {
+			return lvls[i:]
+		}
+	}
+
+	return []LogLevel{}
+}
+
 func NewLogEntrySorter(size int, sortFunc LogEntrySortFunc) *LogEntrySorter {
 	return &LogEntrySorter{
 		entries:  make([]LogEntry, 0, size),
@@ -114,6 +167,19 @@ const (
 	OrderDesc = "descending"
 )
 
+// Clause returns the OCM order clause sorting by the given field in
+// this order, e.g. 'timestamp desc', or an empty string for OrderNone.
+func (o Order) Clause(field string) string {
+	switch o {
+	case OrderAsc:
+		return field + " asc"
+	case OrderDesc:
+		return field + " desc"
+	default:
+		return ""
+	}
+}
+
 type LogEntryOption func(*LogEntryConfig)
 
 func LogEntryDescription(desc string) LogEntryOption {
@@ -5,13 +5,141 @@
 package ocm_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/mt-sre/ocm-addons/internal/cli"
 	"github.com/mt-sre/ocm-addons/internal/ocm"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
 	"github.com/stretchr/testify/require"
 )
 
 func TestLogEntryInterfaces(t *testing.T) {
 	require.Implements(t, new(cli.RowDataProvider), new(ocm.LogEntry))
 }
+
+func TestGetLogsOptionsQuery(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Options  []ocm.GetLogsOption
+		Expected string
+	}{
+		"no options": {
+			Expected: "",
+		},
+		"single level": {
+			Options: []ocm.GetLogsOption{
+				ocm.GetLogsWithLevel(ocm.LogLevelError),
+			},
+			Expected: "severity in ('Error')",
+		},
+		"multiple levels": {
+			Options: []ocm.GetLogsOption{
+				ocm.GetLogsWithLevel(ocm.LogLevelWarning, ocm.LogLevelError),
+			},
+			Expected: "severity in ('Warning', 'Error')",
+		},
+		"minimum level": {
+			Options: []ocm.GetLogsOption{
+				ocm.GetLogsWithMinLevel(ocm.LogLevelWarning),
+			},
+			Expected: "severity in ('Warning', 'Error', 'Fatal')",
+		},
+		"no level": {
+			Options: []ocm.GetLogsOption{
+				ocm.GetLogsWithLevel(ocm.LogLevelNone),
+				ocm.GetLogsWithMinLevel(ocm.LogLevelNone),
+			},
+			Expected: "",
+		},
+		"pattern and level": {
+			Options: []ocm.GetLogsOption{
+				ocm.GetLogsMatchingPattern("%failed%"),
+				ocm.GetLogsWithLevel(ocm.LogLevelInfo),
+			},
+			Expected: "description like '%failed%' and severity in ('Info')",
+		},
+		"multiple service names": {
+			Options: []ocm.GetLogsOption{
+				ocm.GetLogsWithServiceName("SREManualAction", "OtherService"),
+			},
+			Expected: "service_name in ('SREManualAction', 'OtherService')",
+		},
+		"service name and summary": {
+			Options: []ocm.GetLogsOption{
+				ocm.GetLogsWithServiceName("SREManualAction"),
+				ocm.GetLogsWithSummary("StorageNearlyFull"),
+			},
+			Expected: "service_name = 'SREManualAction' and summary = 'StorageNearlyFull'",
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := ocm.NewGetLogsOptions(tc.Options...)
+
+			require.Equal(t, tc.Expected, opts.Query().String())
+		})
+	}
+}
+
+func TestGetLogsOptionsQueryLocalTime(t *testing.T) { //nolint:paralleltest
+	local := time.Local
+
+	t.Cleanup(func() { time.Local = local })
+
+	time.Local = time.FixedZone("UTC+2", 2*60*60)
+
+	after := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC).Local()
+	before := time.Date(2022, 1, 2, 10, 0, 0, 0, time.UTC).Local()
+
+	opts := ocm.NewGetLogsOptions(
+		ocm.GetLogsAfter(after),
+		ocm.GetLogsBefore(before),
+	)
+
+	require.Equal(t,
+		"timestamp >= '2022-01-01 10:00:00' and timestamp <= '2022-01-02 10:00:00'",
+		opts.Query().String(),
+	)
+}
+
+func TestLogEntryJSON(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	entry, err := slv1.NewLogEntry().
+		ID("test-id").
+		ClusterUUID("test-uuid").
+		Description("Test").
+		InternalOnly(true).
+		Severity(slv1.SeverityWarning).
+		Timestamp(ts).
+		Build()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(&ocm.LogEntry{Entry: entry})
+	require.NoError(t, err)
+
+	var decoded ocm.LogEntry
+
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, "test-id", decoded.Entry.ID())
+	require.Equal(t, "test-uuid", decoded.Entry.ClusterUUID())
+	require.True(t, decoded.Entry.InternalOnly())
+	require.Equal(t, slv1.SeverityWarning, decoded.Entry.Severity())
+	require.True(t, ts.Equal(decoded.Entry.Timestamp()))
+}
+
+func TestOrderClause(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "timestamp asc", ocm.Order(ocm.OrderAsc).Clause("timestamp"))
+	require.Equal(t, "timestamp desc", ocm.Order(ocm.OrderDesc).Clause("timestamp"))
+	require.Empty(t, ocm.Order(ocm.OrderNone).Clause("timestamp"))
+}
@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+func NewLogStats(opts ...LogStatsOption) *LogStats {
+	var cfg LogStatsConfig
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	cfg.Default()
+
+	return &LogStats{
+		cfg:       cfg,
+		buckets:   make(map[time.Time]*LogStatsBucket),
+		summaries: make(map[string]*LogSummaryCount),
+	}
+}
+
+// LogStats aggregates log entries into time buckets which are further
+// broken down by a configurable grouping such as severity or service name.
+type LogStats struct {
+	cfg       LogStatsConfig
+	buckets   map[time.Time]*LogStatsBucket
+	summaries map[string]*LogSummaryCount
+	total     int
+}
+
+// Add records a single log entry.
+func (s *LogStats) Add(e LogEntry) {
+	start := s.cfg.BucketSize.Truncate(e.Entry.Timestamp())
+
+	bucket, ok := s.buckets[start]
+	if !ok {
+		bucket = &LogStatsBucket{
+			Start:  start,
+			Counts: make(map[string]int),
+		}
+
+		s.buckets[start] = bucket
+	}
+
+	bucket.Counts[s.cfg.GroupBy.Key(e)]++
+	bucket.Total++
+
+	summary, ok := s.summaries[e.Entry.Summary()]
+	if !ok {
+		summary = &LogSummaryCount{
+			Summary:     e.Entry.Summary(),
+			Severity:    string(e.Entry.Severity()),
+			ServiceName: e.Entry.ServiceName(),
+		}
+
+		s.summaries[e.Entry.Summary()] = summary
+	}
+
+	summary.Count++
+
+	if ts := e.Entry.Timestamp(); ts.After(summary.LastSeen) {
+		summary.LastSeen = ts
+	}
+
+	s.total++
+}
+
+// Total returns the number of log entries recorded.
+func (s *LogStats) Total() int { return s.total }
+
+// MaxLogStatsBuckets is the largest number of buckets returned by
+// LogStats.Buckets.
+const MaxLogStatsBuckets = 1000
+
+// ErrTooManyBuckets is returned by LogStats.Buckets if the recorded
+// entries span more than MaxLogStatsBuckets buckets.
+var ErrTooManyBuckets = errors.New("too many buckets")
+
+// Buckets returns all buckets from the first to the last recorded
+// entry ordered by time. Buckets in between without any entries are
+// included with a total of zero so that gaps remain visible. An error
+// wrapping ErrTooManyBuckets is returned if there would be more than
+// MaxLogStatsBuckets buckets.
+func (s *LogStats) Buckets() ([]LogStatsBucket, error) {
+	if len(s.buckets) == 0 {
+		return nil, nil
+	}
+
+	starts := make([]time.Time, 0, len(s.buckets))
+
+	for start := range s.buckets {
+		starts = append(starts, start)
+	}
+
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+
+	first, last := starts[0], starts[len(starts)-1]
+
+	var result []LogStatsBucket
+
+	for start := first; !start.After(last); start = s.cfg.BucketSize.Next(start) {
+		if len(result) == MaxLogStatsBuckets {
+			return nil, fmt.Errorf(
+				"%s to %s exceeds %d %s buckets: %w",
+				first.Format(time.RFC3339), last.Format(time.RFC3339), MaxLogStatsBuckets, s.cfg.BucketSize,
+				ErrTooManyBuckets,
+			)
+		}
+
+		if b, ok := s.buckets[start]; ok {
+			result = append(result, *b)
+
+			continue
+		}
+
+		result = append(result, LogStatsBucket{
+			Start:  start,
+			Counts: make(map[string]int),
+		})
+	}
+
+	return result, nil
+}
+
+// TopSummaries returns up to 'n' summaries ordered from most to least
+// frequent. Ties are broken by the most recent occurrence. If 'n' is
+// less than 1 all summaries are returned.
+func (s *LogStats) TopSummaries(n int) []LogSummaryCount {
+	result := make([]LogSummaryCount, 0, len(s.summaries))
+
+	for _, sum := range s.summaries {
+		result = append(result, *sum)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+
+		return result[i].LastSeen.After(result[j].LastSeen)
+	})
+
+	if n > 0 && len(result) > n {
+		result = result[:n]
+	}
+
+	return result
+}
+
+// LogStatsBucket holds the counts of log entries which occurred
+// within a single time bucket.
+type LogStatsBucket struct {
+	Start  time.Time
+	Counts map[string]int
+	Total  int
+}
+
+// Groups returns the group keys present in the bucket in sorted order.
+func (b *LogStatsBucket) Groups() []string {
+	result := make([]string, 0, len(b.Counts))
+
+	for g := range b.Counts {
+		result = append(result, g)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// LogSummaryCount records how often log entries with a given
+// summary occurred.
+type LogSummaryCount struct {
+	Summary     string
+	Severity    string
+	ServiceName string
+	Count       int
+	LastSeen    time.Time
+}
+
+func (c *LogSummaryCount) ProvideRowData() map[string]interface{} {
+	return map[string]interface{}{
+		"count":        c.Count,
+		"last_seen":    c.LastSeen,
+		"service_name": c.ServiceName,
+		"severity":     strings.ToUpper(c.Severity),
+		"summary":      c.Summary,
+	}
+}
+
+type LogStatsConfig struct {
+	BucketSize LogBucketSize
+	GroupBy    LogGroupBy
+}
+
+func (c *LogStatsConfig) Default() {
+	if c.BucketSize == LogBucketNone {
+		c.BucketSize = LogBucketDay
+	}
+
+	if c.GroupBy == LogGroupNone {
+		c.GroupBy = LogGroupBySeverity
+	}
+}
+
+type LogStatsOption func(*LogStatsConfig)
+
+func LogStatsBucketSize(size LogBucketSize) LogStatsOption {
+	return func(c *LogStatsConfig) {
+		c.BucketSize = size
+	}
+}
+
+func LogStatsGroupBy(group LogGroupBy) LogStatsOption {
+	return func(c *LogStatsConfig) {
+		c.GroupBy = group
+	}
+}
+
+type LogBucketSize string
+
+const (
+	LogBucketNone = ""
+	LogBucketHour = "hour"
+	LogBucketDay  = "day"
+)
+
+// Truncate returns the start of the bucket the given time falls into.
+// Buckets are always computed in UTC.
+func (s LogBucketSize) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+
+	switch s {
+	case LogBucketHour:
+		return t.Truncate(time.Hour)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Next returns the start of the bucket following the bucket
+// starting at the given time.
+func (s LogBucketSize) Next(start time.Time) time.Time {
+	if s == LogBucketHour {
+		return start.Add(time.Hour)
+	}
+
+	return start.AddDate(0, 0, 1)
+}
+
+// Format returns a display value for a bucket starting at the given time.
+func (s LogBucketSize) Format(t time.Time) string {
+	if s == LogBucketHour {
+		return t.Format("2006-01-02 15:00")
+	}
+
+	return t.Format("2006-01-02")
+}
+
+type LogGroupBy string
+
+const (
+	LogGroupNone          = ""
+	LogGroupBySeverity    = "severity"
+	LogGroupByServiceName = "service_name"
+)
+
+// Key returns the group the supplied log entry belongs to.
+func (g LogGroupBy) Key(e LogEntry) string {
+	if g == LogGroupByServiceName {
+		return e.Entry.ServiceName()
+	}
+
+	return strings.ToUpper(string(e.Entry.Severity()))
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mt-sre/ocm-addons/internal/cli"
+	"github.com/mt-sre/ocm-addons/internal/ocm"
+	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSummaryCountInterfaces(t *testing.T) {
+	require.Implements(t, new(cli.RowDataProvider), new(ocm.LogSummaryCount))
+}
+
+func TestLogStatsBuckets(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 1, 1, 10, 15, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		Options  []ocm.LogStatsOption
+		Expected []map[string]int
+	}{
+		"daily by severity": {
+			Options: []ocm.LogStatsOption{
+				ocm.LogStatsBucketSize(ocm.LogBucketDay),
+				ocm.LogStatsGroupBy(ocm.LogGroupBySeverity),
+			},
+			Expected: []map[string]int{
+				{"ERROR": 2, "INFO": 1},
+				{"WARNING": 1},
+			},
+		},
+		"hourly by service name": {
+			Options: []ocm.LogStatsOption{
+				ocm.LogStatsBucketSize(ocm.LogBucketHour),
+				ocm.LogStatsGroupBy(ocm.LogGroupByServiceName),
+			},
+			Expected: append(append([]map[string]int{
+				{"svc-a": 2},
+				{},
+				{"svc-b": 1},
+			}, emptyBuckets(21)...),
+				map[string]int{"svc-a": 1},
+			),
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stats := ocm.NewLogStats(tc.Options...)
+
+			stats.Add(logEntry(t, base, "Error", "svc-a", "Failed"))
+			stats.Add(logEntry(t, base.Add(10*time.Minute), "Error", "svc-a", "Failed"))
+			stats.Add(logEntry(t, base.Add(2*time.Hour), "Info", "svc-b", "Installed"))
+			stats.Add(logEntry(t, base.Add(24*time.Hour), "Warning", "svc-a", "Degraded"))
+
+			buckets, err := stats.Buckets()
+			require.NoError(t, err)
+			require.Len(t, buckets, len(tc.Expected))
+
+			for i, b := range buckets {
+				assert.Equal(t, tc.Expected[i], b.Counts)
+			}
+
+			assert.Equal(t, 4, stats.Total())
+		})
+	}
+}
+
+func TestLogStatsTooManyBuckets(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	stats := ocm.NewLogStats(ocm.LogStatsBucketSize(ocm.LogBucketHour))
+
+	stats.Add(logEntry(t, base, "Info", "svc", "Installed"))
+	stats.Add(logEntry(t, base.Add(ocm.MaxLogStatsBuckets*time.Hour), "Info", "svc", "Installed"))
+
+	_, err := stats.Buckets()
+	assert.ErrorIs(t, err, ocm.ErrTooManyBuckets)
+
+	stats = ocm.NewLogStats(ocm.LogStatsBucketSize(ocm.LogBucketDay))
+
+	stats.Add(logEntry(t, base, "Info", "svc", "Installed"))
+	stats.Add(logEntry(t, base.Add(ocm.MaxLogStatsBuckets*time.Hour), "Info", "svc", "Installed"))
+
+	buckets, err := stats.Buckets()
+	require.NoError(t, err)
+	assert.Len(t, buckets, ocm.MaxLogStatsBuckets/24+1)
+}
+
+func emptyBuckets(n int) []map[string]int {
+	result := make([]map[string]int, 0, n)
+
+	for i := 0; i < n; i++ {
+		result = append(result, map[string]int{})
+	}
+
+	return result
+}
+
+func TestLogStatsTopSummaries(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	stats := ocm.NewLogStats()
+
+	stats.Add(logEntry(t, base, "Error", "svc", "Failed"))
+	stats.Add(logEntry(t, base.Add(time.Hour), "Info", "svc", "Installed"))
+	stats.Add(logEntry(t, base.Add(2*time.Hour), "Error", "svc", "Failed"))
+	stats.Add(logEntry(t, base.Add(3*time.Hour), "Warning", "svc", "Degraded"))
+
+	top := stats.TopSummaries(2)
+	require.Len(t, top, 2)
+
+	assert.Equal(t, "Failed", top[0].Summary)
+	assert.Equal(t, 2, top[0].Count)
+	assert.Equal(t, base.Add(2*time.Hour), top[0].LastSeen)
+	assert.Equal(t, "Degraded", top[1].Summary)
+
+	assert.Len(t, stats.TopSummaries(0), 3)
+}
+
+func logEntry(t *testing.T, ts time.Time, severity, service, summary string) ocm.LogEntry {
+	t.Helper()
+
+	entry, err := slv1.NewLogEntry().
+		Timestamp(ts).
+		Severity(slv1.Severity(severity)).
+		ServiceName(service).
+		Summary(summary).
+		Build()
+	require.NoError(t, err)
+
+	return ocm.LogEntry{Entry: entry}
+}
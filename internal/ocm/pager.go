@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"context"
+
+	"github.com/apex/log"
+	"github.com/mt-sre/ocm-addons/internal/query"
+	"go.uber.org/multierr"
+)
+
+// DefaultPageSize is the number of items requested per page
+// unless configured otherwise.
+const DefaultPageSize = 50
+
+// newPager returns a Pager which requests pages of items of the
+// given kind, e.g. "cluster", using fetch.
+func newPager[T any](kind string, fetch pageFetcher[T], cfg PagerConfig) *Pager[T] {
+	cfg.Default()
+
+	return &Pager[T]{
+		cfg:   cfg,
+		fetch: fetch,
+		index: 1,
+		kind:  kind,
+	}
+}
+
+// Pager retains state for paged requests of items of type T and
+// maintains a buffer of the last page of items. Requests are limited
+// to the page size, maximum number of items and order of its PagerConfig.
+type Pager[T any] struct {
+	buffer    []T
+	cfg       PagerConfig
+	fetch     pageFetcher[T]
+	finalPage bool
+	index     int
+	kind      string
+	query     query.Expr
+	returned  int
+	total     int
+}
+
+// pageFetcher requests a single page of items.
+type pageFetcher[T any] func(ctx context.Context, params pageParams) (pageResult[T], error)
+
+// pageParams are the parameters of a request for a single page.
+type pageParams struct {
+	// Page is the 1-based number of the page.
+	Page int
+	Size int
+	// Search is an OCM search query or empty to request all items.
+	Search string
+	// Order is an OCM order clause, e.g. 'name desc', or empty
+	// to request the default order.
+	Order string
+}
+
+func (p pageParams) fields() log.Fields {
+	return log.Fields{
+		"page":   p.Page,
+		"size":   p.Size,
+		"search": p.Search,
+		"order":  p.Order,
+	}
+}
+
+type pageResult[T any] struct {
+	Items []T
+	// Size is the number of items in the page as reported by OCM.
+	Size int
+	// Total is the number of items matching the request as
+	// reported by OCM or zero if unknown.
+	Total int
+}
+
+// Search filters the items requested by a search expression.
+// If the Pager is already filtered both expressions must match.
+func (p *Pager[T]) Search(expr query.Expr) *Pager[T] {
+	pager := newPager(p.kind, p.fetch, p.cfg)
+	pager.query = query.And(p.query, expr)
+
+	return pager
+}
+
+// Query returns the search expression filtering the requested items.
+func (p *Pager[T]) Query() query.Expr { return p.query }
+
+// Total returns the number of items matching the request as reported
+// by OCM for the last page, bounded by the limit of the Pager.
+func (p *Pager[T]) Total() int {
+	if p.cfg.Limit > 0 && p.total > p.cfg.Limit {
+		return p.cfg.Limit
+	}
+
+	return p.total
+}
+
+// ForEach iterates over the items requested by a Pager applying
+// the provided function. The iteration will stop with the first
+// error returned by the provided function unless a WithContinueOnError
+// option is given in which case the errors for all items are
+// returned combined as ItemErrors.
+func (p *Pager[T]) ForEach(ctx context.Context, applyFunc func(*T) error, opts ...ForEachOption) error {
+	var (
+		cfg  ForEachConfig
+		errs error
+	)
+
+	cfg.Option(opts...)
+	cfg.Default()
+
+	for {
+		items, hasMorePages, err := p.NextPage(ctx)
+		if err != nil {
+			return multierr.Append(errs, err)
+		}
+
+		if !hasMorePages {
+			return errs
+		}
+
+		cfg.Progress.PageFetched(p.index-1, p.Total())
+
+		more, err := applyEach(ctx, cfg, items, p.itemError, applyFunc)
+
+		multierr.AppendInto(&errs, err)
+
+		if !more {
+			return errs
+		}
+	}
+}
+
+func (p *Pager[T]) itemError(item *T, err error) *ItemError {
+	itemErr := &ItemError{
+		Kind: p.kind,
+		Err:  err,
+	}
+
+	if named, ok := any(item).(interface {
+		ID() string
+		Name() string
+	}); ok {
+		itemErr.ID = named.ID()
+		itemErr.Name = named.Name()
+	}
+
+	return itemErr
+}
+
+// NextPage returns the next page of requested items if there are any remaining.
+// If no items remain the second return value will be 'false'.
+func (p *Pager[T]) NextPage(ctx context.Context) ([]T, bool, error) {
+	if p.finalPage {
+		return nil, false, nil
+	}
+
+	size := p.cfg.PageSize
+	if p.cfg.Limit > 0 && p.cfg.Limit < size {
+		size = p.cfg.Limit
+	}
+
+	res, err := p.fetch(ctx, pageParams{
+		Page:   p.index,
+		Size:   size,
+		Search: p.query.String(),
+		Order:  p.cfg.Order,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	p.buffer = res.Items
+	p.total = res.Total
+
+	if res.Size < size {
+		p.finalPage = true
+	}
+
+	if p.cfg.Limit > 0 && p.returned+len(p.buffer) >= p.cfg.Limit {
+		p.buffer = p.buffer[:p.cfg.Limit-p.returned]
+		p.finalPage = true
+	}
+
+	p.returned += len(p.buffer)
+	p.index++
+
+	return p.buffer, true, nil
+}
+
+type PagerConfig struct {
+	// PageSize is the number of items requested per page.
+	PageSize int
+	// Limit is the maximum number of items requested in total.
+	// A value of zero requests all items.
+	Limit int
+	// Order is an OCM order clause, e.g. 'name desc'.
+	Order string
+}
+
+func (c *PagerConfig) Default() {
+	if c.PageSize < 1 {
+		c.PageSize = DefaultPageSize
+	}
+}
+
+// WithPaging configures the page size, limit and order of the
+// pagers returned by RetrieveAddons and RetrieveClusters.
+type WithPaging PagerConfig
+
+func (wp WithPaging) ConfigureAddon(c *AddonConfig) {
+	c.Paging = PagerConfig(wp)
+}
+
+func (wp WithPaging) ConfigureCluster(c *ClusterConfig) {
+	c.Paging = PagerConfig(wp)
+}
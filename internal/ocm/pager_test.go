@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mt-sre/ocm-addons/internal/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagerPaging(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Config         PagerConfig
+		ExpectedItems  int
+		ExpectedParams []pageParams
+	}{
+		"defaults": {
+			ExpectedItems: 120,
+			ExpectedParams: []pageParams{
+				{Page: 1, Size: DefaultPageSize},
+				{Page: 2, Size: DefaultPageSize},
+				{Page: 3, Size: DefaultPageSize},
+			},
+		},
+		"page size and order": {
+			Config:        PagerConfig{PageSize: 100, Order: "name desc"},
+			ExpectedItems: 120,
+			ExpectedParams: []pageParams{
+				{Page: 1, Size: 100, Order: "name desc"},
+				{Page: 2, Size: 100, Order: "name desc"},
+			},
+		},
+		"limit spanning pages": {
+			Config:        PagerConfig{PageSize: 50, Limit: 70},
+			ExpectedItems: 70,
+			ExpectedParams: []pageParams{
+				{Page: 1, Size: 50},
+				{Page: 2, Size: 50},
+			},
+		},
+		"limit below page size": {
+			Config:        PagerConfig{Limit: 5},
+			ExpectedItems: 5,
+			ExpectedParams: []pageParams{
+				{Page: 1, Size: 5},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var params []pageParams
+
+			pager := newPager("item", func(_ context.Context, p pageParams) (pageResult[int], error) {
+				params = append(params, p)
+
+				return intPage(120, p), nil
+			}, tc.Config)
+
+			var items []int
+
+			require.NoError(t, pager.ForEach(context.Background(), func(i *int) error {
+				items = append(items, *i)
+
+				return nil
+			}))
+
+			assert.Len(t, items, tc.ExpectedItems)
+			assert.Equal(t, tc.ExpectedParams, params)
+			assert.Equal(t, tc.ExpectedItems, pager.Total())
+		})
+	}
+}
+
+func TestPagerSearch(t *testing.T) {
+	t.Parallel()
+
+	var params []pageParams
+
+	pager := newPager("item", func(_ context.Context, p pageParams) (pageResult[int], error) {
+		params = append(params, p)
+
+		return intPage(0, p), nil
+	}, PagerConfig{})
+
+	filtered := pager.Search(query.Raw("name = 'a' or id = 'b'")).Search(query.Equal("id", "c"))
+
+	require.NoError(t, filtered.ForEach(context.Background(), func(*int) error { return nil }))
+	require.Len(t, params, 1)
+	assert.Equal(t, "(name = 'a' or id = 'b') and id = 'c'", params[0].Search)
+	assert.True(t, pager.Query().IsZero(), "should not modify the unfiltered pager")
+}
+
+// intPage returns the requested page of the integers [0,total).
+func intPage(total int, params pageParams) pageResult[int] {
+	var items []int
+
+	for i := (params.Page - 1) * params.Size; i < total && len(items) < params.Size; i++ {
+		items = append(items, i)
+	}
+
+	return pageResult[int]{
+		Items: items,
+		Size:  len(items),
+		Total: total,
+	}
+}
@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/apex/log"
+	sdkerrors "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+// NewRetryPolicy returns a RetryPolicy which, unless configured
+// otherwise, makes up to DefaultMaxAttempts attempts.
+func NewRetryPolicy(opts ...RetryPolicyOption) *RetryPolicy {
+	var policy RetryPolicy
+
+	policy.cfg.Option(opts...)
+	policy.cfg.Default()
+
+	return &policy
+}
+
+// RetryPolicy retries OCM requests which fail with transient errors
+// such as rate limiting (429), server errors (5xx) or broken
+// connections. Attempts are delayed with exponential backoff and
+// jitter unless the response names a delay with 'Retry-After'.
+type RetryPolicy struct {
+	cfg RetryPolicyConfig
+}
+
+// MaxAttempts returns the maximum number of attempts made per request.
+func (p *RetryPolicy) MaxAttempts() int { return p.cfg.MaxAttempts }
+
+// retryMode selects which failed requests may be retried.
+type retryMode int
+
+const (
+	// retryTransient retries idempotent requests after any
+	// transient error.
+	retryTransient retryMode = iota
+	// retryUnsent retries non-idempotent requests only after errors
+	// which guarantee the request was not processed: the request was
+	// rate limited or a connection could not be established.
+	retryUnsent
+)
+
+// retry calls send until it succeeds, fails with an error which may
+// not be retried under mode, the attempts of the policy are exhausted
+// or ctx is done. The last response and error are returned.
+func retry[R any](
+	ctx context.Context,
+	policy *RetryPolicy,
+	logger log.Interface,
+	mode retryMode,
+	send func(context.Context) (R, error),
+) (R, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := send(ctx)
+		if err == nil || ctx.Err() != nil || attempt >= policy.cfg.MaxAttempts {
+			return res, err
+		}
+
+		delay, ok := policy.delay(attempt, mode, responseHeader(res), err)
+		if !ok {
+			return res, err
+		}
+
+		logger.
+			WithError(err).
+			WithFields(log.Fields{
+				"attempt": attempt,
+				"delay":   delay.String(),
+			}).
+			Warn("retrying OCM request")
+
+		if sleepErr := policy.cfg.Sleep(ctx, delay); sleepErr != nil {
+			return res, err
+		}
+	}
+}
+
+// delay returns how long to wait before the next attempt after the given
+// failed attempt and false if the request must not be retried.
+func (p *RetryPolicy) delay(attempt int, mode retryMode, header http.Header, err error) (time.Duration, bool) {
+	status := errorStatus(err)
+
+	switch {
+	case status == http.StatusTooManyRequests:
+	case mode == retryTransient && isTransientStatus(status):
+	case mode == retryTransient && status == 0 && isConnectionError(err):
+	case mode == retryUnsent && status == 0 && isDialError(err):
+	default:
+		return 0, false
+	}
+
+	if delay, ok := p.retryAfter(header); ok {
+		if delay > p.cfg.MaxRetryAfter {
+			return 0, false
+		}
+
+		return delay, true
+	}
+
+	return p.backoff(attempt), true
+}
+
+// backoff doubles the initial delay for every failed attempt up to the
+// maximum delay and picks a random delay between half and all of it.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.cfg.MaxDelay
+
+	if shift := attempt - 1; shift < 32 {
+		if d := p.cfg.InitialDelay << shift; d > 0 && d < delay {
+			delay = d
+		}
+	}
+
+	half := delay / 2 //nolint:gomnd
+
+	return half + time.Duration(p.cfg.Jitter()*float64(delay-half))
+}
+
+// retryAfter parses a 'Retry-After' header given either in seconds
+// or as an HTTP date.
+func (p *RetryPolicy) retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(p.cfg.Now()); delay > 0 {
+			return delay, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func responseHeader(res any) http.Header {
+	if r, ok := res.(interface{ Header() http.Header }); ok {
+		return r.Header()
+	}
+
+	return nil
+}
+
+func errorStatus(err error) int {
+	var sdkErr *sdkerrors.Error
+
+	if errors.As(err, &sdkErr) {
+		return sdkErr.Status()
+	}
+
+	return 0
+}
+
+func isTransientStatus(status int) bool {
+	switch status {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func isConnectionError(err error) bool {
+	var netErr net.Error
+
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+const (
+	// DefaultMaxAttempts is the number of attempts made per request
+	// unless configured otherwise.
+	DefaultMaxAttempts = 4
+
+	_defaultInitialDelay  = 500 * time.Millisecond
+	_defaultMaxDelay      = 30 * time.Second
+	_defaultMaxRetryAfter = 2 * time.Minute
+)
+
+type RetryPolicyConfig struct {
+	// MaxAttempts is the maximum number of attempts made per request.
+	// A value of one disables retries.
+	MaxAttempts int
+	// InitialDelay is the delay before the second attempt which is
+	// doubled for every following attempt.
+	InitialDelay time.Duration
+	// MaxDelay bounds the delay between attempts.
+	MaxDelay time.Duration
+	// MaxRetryAfter bounds the delay requested by 'Retry-After'.
+	// Requests are not retried if a longer delay is requested.
+	MaxRetryAfter time.Duration
+	// Jitter returns a random number in [0.0,1.0).
+	Jitter func() float64
+	Now    func() time.Time
+	Sleep  func(context.Context, time.Duration) error
+}
+
+func (c *RetryPolicyConfig) Option(opts ...RetryPolicyOption) {
+	for _, opt := range opts {
+		opt.ConfigureRetryPolicy(c)
+	}
+}
+
+func (c *RetryPolicyConfig) Default() {
+	if c.MaxAttempts < 1 {
+		c.MaxAttempts = DefaultMaxAttempts
+	}
+
+	if c.InitialDelay <= 0 {
+		c.InitialDelay = _defaultInitialDelay
+	}
+
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = _defaultMaxDelay
+	}
+
+	if c.MaxRetryAfter <= 0 {
+		c.MaxRetryAfter = _defaultMaxRetryAfter
+	}
+
+	if c.Jitter == nil {
+		c.Jitter = rand.Float64 //nolint:gosec
+	}
+
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+
+	if c.Sleep == nil {
+		c.Sleep = sleep
+	}
+}
+
+type RetryPolicyOption interface {
+	ConfigureRetryPolicy(*RetryPolicyConfig)
+}
+
+// WithMaxAttempts sets the maximum number of attempts made per request.
+type WithMaxAttempts int
+
+func (wm WithMaxAttempts) ConfigureRetryPolicy(c *RetryPolicyConfig) {
+	c.MaxAttempts = int(wm)
+}
+
+// WithInitialDelay sets the delay before the second attempt.
+type WithInitialDelay time.Duration
+
+func (wi WithInitialDelay) ConfigureRetryPolicy(c *RetryPolicyConfig) {
+	c.InitialDelay = time.Duration(wi)
+}
+
+func (wn WithNow) ConfigureRetryPolicy(c *RetryPolicyConfig) {
+	c.Now = wn
+}
+
+// WithSleep replaces the function used to wait between attempts.
+type WithSleep func(context.Context, time.Duration) error
+
+func (ws WithSleep) ConfigureRetryPolicy(c *RetryPolicyConfig) {
+	c.Sleep = ws
+}
+
+// WithJitter replaces the source of randomness for backoff delays.
+type WithJitter func() float64
+
+func (wj WithJitter) ConfigureRetryPolicy(c *RetryPolicyConfig) {
+	c.Jitter = wj
+}
+
+// WithRetryPolicy retries requests made for add-ons and clusters
+// using the given policy.
+type WithRetryPolicy struct{ Policy *RetryPolicy }
+
+func (wr WithRetryPolicy) ConfigureAddon(c *AddonConfig) {
+	c.Retry = wr.Policy
+}
+
+func (wr WithRetryPolicy) ConfigureCluster(c *ClusterConfig) {
+	c.Retry = wr.Policy
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/discard"
+	sdkerrors "github.com/openshift-online/ocm-sdk-go/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	errDial := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	errReset := &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}
+
+	for name, tc := range map[string]struct {
+		Mode             retryMode
+		Failures         []testAttempt
+		ExpectedAttempts int
+		ExpectedDelays   []time.Duration
+		ExpectError      bool
+	}{
+		"success": {
+			ExpectedAttempts: 1,
+		},
+		"transient errors": {
+			Failures: []testAttempt{
+				{Err: statusError(t, http.StatusServiceUnavailable)},
+				{Err: errReset},
+			},
+			ExpectedAttempts: 3,
+			ExpectedDelays:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		"attempts exhausted": {
+			Failures: []testAttempt{
+				{Err: statusError(t, http.StatusBadGateway)},
+				{Err: statusError(t, http.StatusBadGateway)},
+				{Err: statusError(t, http.StatusBadGateway)},
+			},
+			ExpectedAttempts: 3,
+			ExpectedDelays:   []time.Duration{time.Second, 2 * time.Second},
+			ExpectError:      true,
+		},
+		"permanent error": {
+			Failures:         []testAttempt{{Err: statusError(t, http.StatusBadRequest)}},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+		"retry after seconds": {
+			Failures: []testAttempt{{
+				Err:    statusError(t, http.StatusTooManyRequests),
+				Header: http.Header{"Retry-After": []string{"7"}},
+			}},
+			ExpectedAttempts: 2,
+			ExpectedDelays:   []time.Duration{7 * time.Second},
+		},
+		"retry after date": {
+			Failures: []testAttempt{{
+				Err:    statusError(t, http.StatusServiceUnavailable),
+				Header: http.Header{"Retry-After": []string{"Mon, 19 Oct 2026 12:00:30 GMT"}},
+			}},
+			ExpectedAttempts: 2,
+			ExpectedDelays:   []time.Duration{30 * time.Second},
+		},
+		"retry after too long": {
+			Failures: []testAttempt{{
+				Err:    statusError(t, http.StatusTooManyRequests),
+				Header: http.Header{"Retry-After": []string{"3600"}},
+			}},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+		"unsent request rate limited": {
+			Mode:             retryUnsent,
+			Failures:         []testAttempt{{Err: statusError(t, http.StatusTooManyRequests)}},
+			ExpectedAttempts: 2,
+			ExpectedDelays:   []time.Duration{time.Second},
+		},
+		"unsent request not connected": {
+			Mode:             retryUnsent,
+			Failures:         []testAttempt{{Err: errDial}},
+			ExpectedAttempts: 2,
+			ExpectedDelays:   []time.Duration{time.Second},
+		},
+		"non-idempotent request failed by server": {
+			Mode:             retryUnsent,
+			Failures:         []testAttempt{{Err: statusError(t, http.StatusServiceUnavailable)}},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+		"non-idempotent request connection lost": {
+			Mode:             retryUnsent,
+			Failures:         []testAttempt{{Err: errReset}},
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var delays []time.Duration
+
+			policy := NewRetryPolicy(
+				WithMaxAttempts(3),
+				WithInitialDelay(time.Second),
+				WithJitter(func() float64 { return 1 }),
+				WithNow(func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }),
+				WithSleep(func(_ context.Context, d time.Duration) error {
+					delays = append(delays, d)
+
+					return nil
+				}),
+			)
+
+			var attempts int
+
+			res, err := retry(context.Background(), policy, discardLogger(), tc.Mode,
+				func(context.Context) (testResponse, error) {
+					attempts++
+
+					if attempts <= len(tc.Failures) {
+						failure := tc.Failures[attempts-1]
+
+						return testResponse{header: failure.Header}, failure.Err
+					}
+
+					return testResponse{ok: true}, nil
+				},
+			)
+
+			assert.Equal(t, tc.ExpectedAttempts, attempts)
+			assert.Equal(t, tc.ExpectedDelays, delays)
+
+			if tc.ExpectError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, res.ok)
+			}
+		})
+	}
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	policy := NewRetryPolicy(WithInitialDelay(time.Hour))
+
+	var attempts int
+
+	_, err := retry(ctx, policy, discardLogger(), retryTransient, func(context.Context) (testResponse, error) {
+		attempts++
+
+		cancel()
+
+		return testResponse{}, statusError(t, http.StatusServiceUnavailable)
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := NewRetryPolicy(WithJitter(func() float64 { return 0 }))
+
+	assert.Equal(t, _defaultInitialDelay/2, policy.backoff(1))
+	assert.Equal(t, _defaultInitialDelay, policy.backoff(2))
+	assert.Equal(t, _defaultMaxDelay/2, policy.backoff(20))
+	assert.Equal(t, _defaultMaxDelay/2, policy.backoff(100))
+}
+
+type testAttempt struct {
+	Err    error
+	Header http.Header
+}
+
+type testResponse struct {
+	header http.Header
+	ok     bool
+}
+
+func (r testResponse) Header() http.Header { return r.header }
+
+func statusError(t *testing.T, status int) error {
+	t.Helper()
+
+	err, buildErr := sdkerrors.NewError().Status(status).Build()
+	require.NoError(t, buildErr)
+
+	return err
+}
+
+func discardLogger() log.Interface {
+	return &log.Logger{Handler: discard.New()}
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package query builds expressions in the SQL-like search language
+// accepted by the 'search' parameter of OCM list requests, e.g.
+// "name like 'my-%' and product.id = 'rosa'". Values are always quoted
+// and escaped so that input containing quotes can neither break an
+// expression nor change its meaning.
+package query
+
+import (
+	"strings"
+)
+
+// Expr is an expression of the OCM search language. The zero value is
+// the empty expression which matches everything and is omitted when
+// combined with other expressions.
+type Expr struct {
+	// op is "and" or "or" for compound expressions.
+	op       string
+	operands []Expr
+	// text is the rendered predicate for leaf expressions.
+	text string
+	raw  bool
+}
+
+// Raw returns an expression which is used verbatim, e.g. a search
+// entered by the user. It is enclosed in parentheses when combined with
+// other expressions so that its operators cannot affect them.
+func Raw(expr string) Expr {
+	return Expr{text: strings.TrimSpace(expr), raw: true}
+}
+
+// Equal matches items whose field equals value.
+func Equal(field, value string) Expr {
+	return compare(field, "=", value)
+}
+
+// NotEqual matches items whose field differs from value.
+func NotEqual(field, value string) Expr {
+	return compare(field, "!=", value)
+}
+
+// GreaterOrEqual matches items whose field is greater than or equal
+// to value, e.g. timestamps no earlier than the given time.
+func GreaterOrEqual(field, value string) Expr {
+	return compare(field, ">=", value)
+}
+
+// LessOrEqual matches items whose field is less than or equal to value.
+func LessOrEqual(field, value string) Expr {
+	return compare(field, "<=", value)
+}
+
+// Like matches items whose field matches pattern in which '%' matches
+// any sequence of characters and '_' matches any single character.
+func Like(field, pattern string) Expr {
+	return compare(field, "like", pattern)
+}
+
+// In matches items whose field equals any of the values which must
+// not be empty.
+func In(field string, values ...string) Expr {
+	quoted := make([]string, 0, len(values))
+
+	for _, v := range values {
+		quoted = append(quoted, Quote(v))
+	}
+
+	return Expr{text: field + " in (" + strings.Join(quoted, ", ") + ")"}
+}
+
+func compare(field, op, value string) Expr {
+	return Expr{text: field + " " + op + " " + Quote(value)}
+}
+
+// Quote returns value as a string literal enclosed in single quotes
+// where single quotes within value are escaped by doubling them.
+func Quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// And matches items which match all of the expressions.
+// Empty expressions are ignored.
+func And(exprs ...Expr) Expr {
+	return combine("and", exprs)
+}
+
+// Or matches items which match any of the expressions.
+// Empty expressions are ignored.
+func Or(exprs ...Expr) Expr {
+	return combine("or", exprs)
+}
+
+func combine(op string, exprs []Expr) Expr {
+	operands := make([]Expr, 0, len(exprs))
+
+	for _, e := range exprs {
+		switch {
+		case e.IsZero():
+		case e.op == op:
+			// Operands of the same operator are flattened
+			// as 'a and (b and c)' equals 'a and b and c'.
+			operands = append(operands, e.operands...)
+		default:
+			operands = append(operands, e)
+		}
+	}
+
+	switch len(operands) {
+	case 0:
+		return Expr{}
+	case 1:
+		return operands[0]
+	default:
+		return Expr{op: op, operands: operands}
+	}
+}
+
+// IsZero reports whether the expression is empty.
+func (e Expr) IsZero() bool {
+	return e.op == "" && e.text == ""
+}
+
+// String renders the expression for the 'search' parameter
+// of OCM list requests.
+func (e Expr) String() string {
+	if e.op == "" {
+		return e.text
+	}
+
+	parts := make([]string, 0, len(e.operands))
+
+	for _, o := range e.operands {
+		if o.op != "" || o.raw {
+			parts = append(parts, "("+o.String()+")")
+		} else {
+			parts = append(parts, o.String())
+		}
+	}
+
+	return strings.Join(parts, " "+e.op+" ")
+}
+
+// Debug renders the expression as an indented tree with one operator
+// or predicate per line for use in log output.
+func (e Expr) Debug() string {
+	var sb strings.Builder
+
+	e.debug(&sb, 0)
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (e Expr) debug(sb *strings.Builder, depth int) {
+	sb.WriteString(strings.Repeat("  ", depth))
+
+	if e.op == "" {
+		sb.WriteString(e.text)
+		sb.WriteString("\n")
+
+		return
+	}
+
+	sb.WriteString(e.op)
+	sb.WriteString("\n")
+
+	for _, o := range e.operands {
+		o.debug(sb, depth+1)
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 Red Hat, Inc. <sd-mt-sre@redhat.com>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExprString(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		Expr     Expr
+		Expected string
+	}{
+		"empty": {
+			Expr: And(Expr{}, Or()),
+		},
+		"equal": {
+			Expr:     Equal("id", "abc"),
+			Expected: "id = 'abc'",
+		},
+		"quotes are escaped": {
+			Expr:     Like("name", "o'brien' or name like '%"),
+			Expected: "name like 'o''brien'' or name like ''%'",
+		},
+		"in": {
+			Expr:     In("severity", "Info", "it's"),
+			Expected: "severity in ('Info', 'it''s')",
+		},
+		"single operand": {
+			Expr:     And(Expr{}, Equal("id", "a")),
+			Expected: "id = 'a'",
+		},
+		"nested operators": {
+			Expr: And(
+				Or(Like("name", "a%"), Equal("id", "a")),
+				Equal("organization.id", "org"),
+				And(Equal("product.id", "rosa"), NotEqual("state", "ready")),
+			),
+			Expected: "(name like 'a%' or id = 'a') and organization.id = 'org' " +
+				"and product.id = 'rosa' and state != 'ready'",
+		},
+		"raw": {
+			Expr:     And(Raw(" region.id = 'us-east-1' or region.id = 'us-east-2' "), Equal("managed", "true")),
+			Expected: "(region.id = 'us-east-1' or region.id = 'us-east-2') and managed = 'true'",
+		},
+		"raw alone": {
+			Expr:     And(Raw("a = 'b' or c = 'd'")),
+			Expected: "a = 'b' or c = 'd'",
+		},
+	} {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.Expected, tc.Expr.String())
+			assert.Equal(t, tc.Expected == "", tc.Expr.IsZero())
+		})
+	}
+}
+
+func TestExprDebug(t *testing.T) {
+	t.Parallel()
+
+	expr := And(
+		Or(Like("name", "a%"), Equal("id", "a")),
+		GreaterOrEqual("timestamp", "2026-01-01"),
+	)
+
+	assert.Equal(t, "and\n"+
+		"  or\n"+
+		"    name like 'a%'\n"+
+		"    id = 'a'\n"+
+		"  timestamp >= '2026-01-01'",
+		expr.Debug(),
+	)
+}